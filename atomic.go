@@ -0,0 +1,65 @@
+package option
+
+import "sync"
+
+// AtomicOption is an optional value that can be safely accessed from multiple goroutines.
+//
+// Every operation is guarded by a mutex, so AtomicOption is not lock-free
+// (atomic.Pointer would require Go 1.19).
+//
+// The zero value is a None. An AtomicOption must not be copied after first use.
+type AtomicOption[T any] struct {
+	mu sync.Mutex
+	o  Option[T]
+}
+
+// Load returns the stored Option.
+func (a *AtomicOption[T]) Load() Option[T] {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.load()
+}
+
+// Store stores o.
+func (a *AtomicOption[T]) Store(o Option[T]) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.o = o
+}
+
+// Swap stores o and returns the previously stored Option.
+func (a *AtomicOption[T]) Swap(o Option[T]) Option[T] {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	old := a.load()
+	a.o = o
+
+	return old
+}
+
+func (a *AtomicOption[T]) load() Option[T] {
+	if a.o == nil {
+		return None[T]()
+	}
+
+	return a.o
+}
+
+// CompareAndSwap stores updated in a if the stored Option equals old (according to Equals) and reports whether it did.
+//
+// It is a function (instead of a method) because it requires a comparable type.
+func CompareAndSwap[T comparable](a *AtomicOption[T], old Option[T], updated Option[T]) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !Equals(a.load(), old) {
+		return false
+	}
+
+	a.o = updated
+
+	return true
+}
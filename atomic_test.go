@@ -0,0 +1,97 @@
+package option
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomicOption(t *testing.T) {
+	var a AtomicOption[int]
+
+	if v := a.Load(); !IsNone(v) {
+		t.Error("expected the zero value to be None, got:", v)
+	}
+
+	a.Store(Some(1))
+
+	if v := a.Load(); !Equals(v, Some(1)) {
+		t.Error("expected Load to return Some(1), got:", v)
+	}
+
+	if v := a.Swap(Some(2)); !Equals(v, Some(1)) {
+		t.Error("expected Swap to return Some(1), got:", v)
+	}
+
+	if v := a.Load(); !Equals(v, Some(2)) {
+		t.Error("expected Load to return Some(2), got:", v)
+	}
+}
+
+func TestCompareAndSwap(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var a AtomicOption[int]
+
+		if !CompareAndSwap(&a, None[int](), Some(1)) {
+			t.Error("expected CompareAndSwap to succeed")
+		}
+
+		if v := a.Load(); !Equals(v, Some(1)) {
+			t.Error("expected CompareAndSwap to store Some(1), got:", v)
+		}
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		var a AtomicOption[int]
+
+		a.Store(Some(1))
+
+		if CompareAndSwap(&a, Some(2), Some(3)) {
+			t.Error("expected CompareAndSwap to fail")
+		}
+
+		if CompareAndSwap(&a, None[int](), Some(3)) {
+			t.Error("expected CompareAndSwap to fail")
+		}
+
+		if v := a.Load(); !Equals(v, Some(1)) {
+			t.Error("expected CompareAndSwap not to store anything, got:", v)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		const goroutines = 50
+
+		var (
+			a         AtomicOption[int]
+			wg        sync.WaitGroup
+			mu        sync.Mutex
+			successes int
+		)
+
+		for i := 0; i < goroutines; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				if CompareAndSwap(&a, None[int](), Some(i)) {
+					mu.Lock()
+					successes++
+					mu.Unlock()
+				}
+
+				_ = a.Load()
+			}(i)
+		}
+
+		wg.Wait()
+
+		if successes != 1 {
+			t.Error("expected exactly one CompareAndSwap to succeed, got:", successes)
+		}
+
+		if v := a.Load(); !IsSome(v) {
+			t.Error("expected a value to be stored, got:", v)
+		}
+	})
+}
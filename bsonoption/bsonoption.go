@@ -0,0 +1,82 @@
+// Package bsonoption provides BSON support for optional values (compatible with the official MongoDB driver).
+//
+// It lives in its own module so that the MongoDB driver does not become a dependency of the option package.
+package bsonoption
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+// Option is an optional value that can be marshaled to and unmarshaled from BSON.
+//
+// A Some is stored as its value, a None is stored as BSON null (or omitted when the field is tagged with omitempty).
+// Decoding a missing field or BSON null yields a None.
+//
+// Option implements [option.Option], so it can be used with the rest of the option package.
+// The zero value is a None.
+type Option[T any] struct {
+	value T
+	valid bool
+}
+
+// From returns a new Option holding the same state as o.
+func From[T any](o option.Option[T]) Option[T] {
+	return Option[T]{
+		value: o.Value(),
+		valid: o.HasValue(),
+	}
+}
+
+// HasValue implements [option.Option].
+func (o Option[T]) HasValue() bool {
+	return o.valid
+}
+
+// Value implements [option.Option].
+func (o Option[T]) Value() T {
+	return o.value
+}
+
+// IsZero reports whether o is a None.
+//
+// The driver uses it to omit Nones from documents when a field is tagged with omitempty.
+func (o Option[T]) IsZero() bool {
+	return !o.valid
+}
+
+// MarshalBSONValue implements [bson.ValueMarshaler].
+func (o Option[T]) MarshalBSONValue() (byte, []byte, error) {
+	if !o.valid {
+		return byte(bson.TypeNull), nil, nil
+	}
+
+	t, data, err := bson.MarshalValue(o.value)
+
+	return byte(t), data, err
+}
+
+// UnmarshalBSONValue implements [bson.ValueUnmarshaler].
+func (o *Option[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	t := bson.Type(typ)
+
+	if t == bson.TypeNull || t == bson.TypeUndefined {
+		*o = Option[T]{}
+
+		return nil
+	}
+
+	var value T
+
+	if err := bson.UnmarshalValue(t, data, &value); err != nil {
+		return err
+	}
+
+	*o = Option[T]{
+		value: value,
+		valid: true,
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package bsonoption
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+type document struct {
+	Name    Option[string] `bson:"name"`
+	Age     Option[int]    `bson:"age"`
+	Comment Option[string] `bson:"comment,omitempty"`
+}
+
+func TestOption(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		doc := document{
+			Name:    From(option.Some("John")),
+			Age:     From(option.Some(42)),
+			Comment: From(option.Some("hello")),
+		}
+
+		data, err := bson.Marshal(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var raw bson.M
+
+		if err := bson.Unmarshal(data, &raw); err != nil {
+			t.Fatal(err)
+		}
+
+		if raw["name"] != "John" {
+			t.Error("expected name to be stored as its value, got:", raw["name"])
+		}
+
+		var decoded document
+
+		if err := bson.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		if !option.Equals[string](decoded.Name, option.Some("John")) {
+			t.Error("expected name to round-trip, got:", decoded.Name)
+		}
+
+		if !option.Equals[int](decoded.Age, option.Some(42)) {
+			t.Error("expected age to round-trip, got:", decoded.Age)
+		}
+
+		if !option.Equals[string](decoded.Comment, option.Some("hello")) {
+			t.Error("expected comment to round-trip, got:", decoded.Comment)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		doc := document{
+			Name: From(option.Some("John")),
+		}
+
+		data, err := bson.Marshal(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var raw bson.M
+
+		if err := bson.Unmarshal(data, &raw); err != nil {
+			t.Fatal(err)
+		}
+
+		if v, ok := raw["age"]; !ok || v != nil {
+			t.Error("expected None to be stored as null, got:", v)
+		}
+
+		if _, ok := raw["comment"]; ok {
+			t.Error("expected None to be omitted with omitempty")
+		}
+
+		decoded := document{
+			Age: From(option.Some(1)),
+		}
+
+		if err := bson.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		if !option.IsNone[int](decoded.Age) {
+			t.Error("expected null to decode as None, got:", decoded.Age)
+		}
+
+		if !option.IsNone[string](decoded.Comment) {
+			t.Error("expected missing field to decode as None, got:", decoded.Comment)
+		}
+	})
+}
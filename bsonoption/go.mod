@@ -0,0 +1,10 @@
+module github.com/sagikazarmark/go-option/bsonoption
+
+go 1.26
+
+require (
+	github.com/sagikazarmark/go-option v0.0.0-20261014104735-5ba0a0fb6eeb
+	go.mongodb.org/mongo-driver/v2 v2.9.1
+)
+
+replace github.com/sagikazarmark/go-option => ../
@@ -0,0 +1,80 @@
+package option
+
+import "reflect"
+
+// SelectFirst blocks until a value is received from any of the provided channels and returns a Some holding it.
+// Closed channels are ignored; if all channels are (or become) closed, it returns a None.
+func SelectFirst[T any](chans ...<-chan T) Option[T] {
+	cases := make([]reflect.SelectCase, 0, len(chans))
+
+	for _, ch := range chans {
+		cases = append(cases, reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(ch),
+		})
+	}
+
+	for len(cases) > 0 {
+		i, v, ok := reflect.Select(cases)
+		if ok {
+			// The type assertion would fail for a nil interface value
+			value, _ := v.Interface().(T)
+
+			return Some(value)
+		}
+
+		cases = append(cases[:i], cases[i+1:]...)
+	}
+
+	return None[T]()
+}
+
+// Tee sends the contained value (if any) on ch and returns o.
+//
+// The send blocks until the value is received (or buffered), so values are never lost.
+// Use TryTee for a non-blocking alternative.
+func Tee[T any](o Option[T], ch chan<- T) Option[T] {
+	if IsSome(o) {
+		ch <- o.Value()
+	}
+
+	return o
+}
+
+// TryTee attempts to send the contained value (if any) on ch without blocking and returns o.
+//
+// If the value cannot be sent immediately (eg. the channel is full), it is dropped.
+func TryTee[T any](o Option[T], ch chan<- T) Option[T] {
+	if IsSome(o) {
+		select {
+		case ch <- o.Value():
+		default:
+		}
+	}
+
+	return o
+}
+
+// ToChan sends the contained value (if any) on ch.
+//
+// The send blocks until the value is received (or buffered).
+// When feeding a buffered channel from a goroutine that must not stall, use TryToChan instead.
+func ToChan[T any](o Option[T], ch chan<- T) {
+	Tee(o, ch)
+}
+
+// TryToChan attempts to send the contained value (if any) on ch without blocking.
+//
+// It returns true if the value was sent, and false if o does not contain a value or the value could not be sent immediately (eg. the buffer is full).
+func TryToChan[T any](o Option[T], ch chan<- T) bool {
+	if IsNone(o) {
+		return false
+	}
+
+	select {
+	case ch <- o.Value():
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,185 @@
+package option
+
+import "testing"
+
+func TestSelectFirst(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		ch1 := make(chan string)
+		ch2 := make(chan string, 1)
+		ch3 := make(chan string)
+
+		close(ch3)
+
+		ch2 <- "hello"
+
+		v := SelectFirst[string](ch1, ch2, ch3)
+
+		if !Equals(v, Some("hello")) {
+			t.Error("expected SelectFirst to return Some(\"hello\"), got:", v)
+		}
+	})
+
+	t.Run("AllClosed", func(t *testing.T) {
+		ch1 := make(chan string)
+		ch2 := make(chan string)
+
+		close(ch1)
+		close(ch2)
+
+		v := SelectFirst[string](ch1, ch2)
+
+		if !IsNone(v) {
+			t.Error("expected SelectFirst to return None, got:", v)
+		}
+	})
+
+	t.Run("NoChannels", func(t *testing.T) {
+		v := SelectFirst[string]()
+
+		if !IsNone(v) {
+			t.Error("expected SelectFirst to return None, got:", v)
+		}
+	})
+}
+
+func TestTee(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		ch := make(chan string, 1)
+
+		o := Some("hello")
+
+		v := Tee(o, ch)
+
+		if !Equals(v, o) {
+			t.Error("expected Tee to return Some(\"hello\"), got:", v)
+		}
+
+		if len(ch) != 1 || <-ch != "hello" {
+			t.Error("expected Tee to send the contained value")
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		ch := make(chan string, 1)
+
+		v := Tee(None[string](), ch)
+
+		if !IsNone(v) {
+			t.Error("expected Tee to return None, got:", v)
+		}
+
+		if len(ch) != 0 {
+			t.Error("expected Tee not to send anything")
+		}
+	})
+}
+
+func TestTryTee(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		ch := make(chan string, 1)
+
+		o := Some("hello")
+
+		v := TryTee(o, ch)
+
+		if !Equals(v, o) {
+			t.Error("expected TryTee to return Some(\"hello\"), got:", v)
+		}
+
+		if len(ch) != 1 || <-ch != "hello" {
+			t.Error("expected TryTee to send the contained value")
+		}
+	})
+
+	t.Run("Full", func(t *testing.T) {
+		ch := make(chan string, 1)
+		ch <- "world"
+
+		o := Some("hello")
+
+		v := TryTee(o, ch)
+
+		if !Equals(v, o) {
+			t.Error("expected TryTee to return Some(\"hello\"), got:", v)
+		}
+
+		if len(ch) != 1 || <-ch != "world" {
+			t.Error("expected TryTee to drop the value")
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		ch := make(chan string, 1)
+
+		v := TryTee(None[string](), ch)
+
+		if !IsNone(v) {
+			t.Error("expected TryTee to return None, got:", v)
+		}
+
+		if len(ch) != 0 {
+			t.Error("expected TryTee not to send anything")
+		}
+	})
+}
+
+func TestToChan(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		ch := make(chan string, 2)
+
+		ToChan(Some("hello"), ch)
+
+		if len(ch) != 1 || <-ch != "hello" {
+			t.Error("expected ToChan to send the contained value exactly once")
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		ch := make(chan string, 1)
+
+		ToChan(None[string](), ch)
+
+		if len(ch) != 0 {
+			t.Error("expected ToChan not to send anything")
+		}
+	})
+}
+
+func TestTryToChan(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		ch := make(chan string, 2)
+
+		if !TryToChan(Some("hello"), ch) {
+			t.Error("expected TryToChan to report the value as sent")
+		}
+
+		if len(ch) != 1 || <-ch != "hello" {
+			t.Error("expected TryToChan to send the contained value exactly once")
+		}
+	})
+
+	t.Run("Full", func(t *testing.T) {
+		ch := make(chan string, 1)
+		ch <- "world"
+
+		if TryToChan(Some("hello"), ch) {
+			t.Error("expected TryToChan to report the value as dropped")
+		}
+
+		if len(ch) != 1 || <-ch != "world" {
+			t.Error("expected TryToChan to drop the value")
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		ch := make(chan string, 1)
+
+		if TryToChan(None[string](), ch) {
+			t.Error("expected TryToChan to report nothing as sent")
+		}
+
+		if len(ch) != 0 {
+			t.Error("expected TryToChan not to send anything")
+		}
+	})
+}
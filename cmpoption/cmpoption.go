@@ -0,0 +1,28 @@
+// Package cmpoption provides go-cmp support for optional values.
+//
+// It lives in its own module so that go-cmp does not become a dependency of the option package.
+package cmpoption
+
+import (
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+// Comparer returns a [cmp.Option] that compares [option.Option] values by presence and contained value
+// (instead of comparing the unexported fields of the underlying implementations).
+//
+// A nil option.Option is considered to be equal to a None.
+func Comparer[T comparable]() cmp.Option {
+	return cmp.Comparer(func(a option.Option[T], b option.Option[T]) bool {
+		if a == nil {
+			a = option.None[T]()
+		}
+
+		if b == nil {
+			b = option.None[T]()
+		}
+
+		return option.Equals(a, b)
+	})
+}
@@ -0,0 +1,66 @@
+package cmpoption
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+type user struct {
+	Name  string
+	Email option.Option[string]
+	Age   option.Option[int]
+}
+
+func TestComparer(t *testing.T) {
+	opts := cmp.Options{Comparer[string](), Comparer[int]()}
+
+	t.Run("Equal", func(t *testing.T) {
+		a := user{Name: "John", Email: option.Some("john@example.com"), Age: option.None[int]()}
+		b := user{Name: "John", Email: option.Some("john@example.com"), Age: option.None[int]()}
+
+		if diff := cmp.Diff(a, b, opts); diff != "" {
+			t.Error("expected no difference, got:", diff)
+		}
+	})
+
+	t.Run("NilIsNone", func(t *testing.T) {
+		a := user{Name: "John", Email: option.None[string]()}
+		b := user{Name: "John", Age: option.None[int]()}
+
+		if diff := cmp.Diff(a, b, opts); diff != "" {
+			t.Error("expected no difference, got:", diff)
+		}
+	})
+
+	tests := []struct {
+		name string
+		a    user
+		b    user
+	}{
+		{"DifferentValue", user{Email: option.Some("john@example.com")}, user{Email: option.Some("jane@example.com")}},
+		{"DifferentPresence", user{Age: option.Some(30)}, user{Age: option.None[int]()}},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.a, test.b, opts); diff == "" {
+				t.Error("expected a difference")
+			}
+		})
+	}
+
+	t.Run("WithoutComparer", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected cmp.Diff to panic on unexported fields without the comparer")
+			}
+		}()
+
+		cmp.Diff(user{Age: option.Some(30)}, user{Age: option.Some(30)})
+	})
+}
@@ -0,0 +1,10 @@
+module github.com/sagikazarmark/go-option/cmpoption
+
+go 1.26
+
+require (
+	github.com/google/go-cmp v0.7.0
+	github.com/sagikazarmark/go-option v0.0.0-20261014104735-5ba0a0fb6eeb
+)
+
+replace github.com/sagikazarmark/go-option => ../
@@ -0,0 +1,16 @@
+package option
+
+// MarshalCSVField formats the contained value (if any) using the provided function or returns an empty string.
+func MarshalCSVField[T any](o Option[T], format func(T) string) string {
+	return MapOr(o, "", format)
+}
+
+// UnmarshalCSVField parses a CSV cell using the provided function or returns a None if the cell is empty.
+// If the function returns an error, it propagates back (with a None).
+func UnmarshalCSVField[T any](cell string, parse func(string) (T, error)) (Option[T], error) {
+	if cell == "" {
+		return None[T](), nil
+	}
+
+	return TryMap(Some(cell), parse)
+}
@@ -0,0 +1,95 @@
+package option
+
+import (
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMarshalCSVField(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v := MarshalCSVField(Some(42), strconv.Itoa)
+
+		if v != "42" {
+			t.Error("expected MarshalCSVField to return \"42\", got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v := MarshalCSVField(None[int](), strconv.Itoa)
+
+		if v != "" {
+			t.Error("expected MarshalCSVField to return an empty string, got:", v)
+		}
+	})
+}
+
+func TestUnmarshalCSVField(t *testing.T) {
+	t.Run("Populated", func(t *testing.T) {
+		v, err := UnmarshalCSVField("42", strconv.Atoi)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !Equals(v, Some(42)) {
+			t.Error("expected UnmarshalCSVField to return Some(42), got:", v)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		v, err := UnmarshalCSVField("", strconv.Atoi)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !IsNone(v) {
+			t.Error("expected UnmarshalCSVField to return None, got:", v)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		v, err := UnmarshalCSVField("hello", strconv.Atoi)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		if !IsNone(v) {
+			t.Error("expected UnmarshalCSVField to return None, got:", v)
+		}
+	})
+}
+
+func TestCSVField(t *testing.T) {
+	var buf strings.Builder
+
+	w := csv.NewWriter(&buf)
+
+	for _, o := range []Option[int]{Some(1), None[int](), Some(3)} {
+		if err := w.Write([]string{"row", MarshalCSVField(o, strconv.Itoa)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w.Flush()
+
+	records, err := csv.NewReader(strings.NewReader(buf.String())).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded []Option[int]
+
+	for _, record := range records {
+		o, err := UnmarshalCSVField(record[1], strconv.Atoi)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded = append(decoded, o)
+	}
+
+	if !equalSlices(decoded, []Option[int]{Some(1), None[int](), Some(3)}) {
+		t.Error("expected the fields to round-trip, got:", decoded)
+	}
+}
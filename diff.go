@@ -0,0 +1,60 @@
+package option
+
+// Transition describes how an Option changed between two states.
+type Transition int
+
+const (
+	// Unchanged means that both Options are None or both are Some with equal values.
+	Unchanged Transition = iota
+
+	// AddedSome means that a None became a Some.
+	AddedSome
+
+	// RemovedSome means that a Some became a None.
+	RemovedSome
+
+	// ChangedValue means that both Options are Some, but their values differ.
+	ChangedValue
+)
+
+// String implements [fmt.Stringer].
+func (t Transition) String() string {
+	switch t {
+	case Unchanged:
+		return "Unchanged"
+
+	case AddedSome:
+		return "AddedSome"
+
+	case RemovedSome:
+		return "RemovedSome"
+
+	case ChangedValue:
+		return "ChangedValue"
+
+	default:
+		return "Transition(unknown)"
+	}
+}
+
+// Changed returns true if presence differs between old and updated or both contain a value, but the values differ.
+func Changed[T comparable](old Option[T], updated Option[T]) bool {
+	return !Equals(old, updated)
+}
+
+// Diff classifies the transition from old to updated.
+func Diff[T comparable](old Option[T], updated Option[T]) Transition {
+	switch {
+	case IsNone(old) && IsSome(updated):
+		return AddedSome
+
+	case IsSome(old) && IsNone(updated):
+		return RemovedSome
+
+	case !Equals(old, updated):
+		return ChangedValue
+
+	default:
+		return Unchanged
+	}
+}
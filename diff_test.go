@@ -0,0 +1,67 @@
+package option
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestChanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      Option[string]
+		updated  Option[string]
+		expected bool
+	}{
+		{"NoneToNone", None[string](), None[string](), false},
+		{"NoneToSome", None[string](), Some("hello"), true},
+		{"SomeToNone", Some("hello"), None[string](), true},
+		{"SomeToSameSome", Some("hello"), Some("hello"), false},
+		{"SomeToDifferentSome", Some("hello"), Some("world"), true},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if v := Changed(test.old, test.updated); v != test.expected {
+				t.Errorf("expected Changed to return %t, got: %t", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      Option[string]
+		updated  Option[string]
+		expected Transition
+	}{
+		{"NoneToNone", None[string](), None[string](), Unchanged},
+		{"NoneToSome", None[string](), Some("hello"), AddedSome},
+		{"SomeToNone", Some("hello"), None[string](), RemovedSome},
+		{"SomeToSameSome", Some("hello"), Some("hello"), Unchanged},
+		{"SomeToDifferentSome", Some("hello"), Some("world"), ChangedValue},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if v := Diff(test.old, test.updated); v != test.expected {
+				t.Errorf("expected Diff to return %s, got: %s", test.expected, v)
+			}
+		})
+	}
+}
+
+func ExampleDiff() {
+	fmt.Println(Diff(None[int](), Some(1)))
+	fmt.Println(Diff(Some(1), Some(2)))
+	fmt.Println(Diff(Some(2), None[int]()))
+
+	// Output:
+	// AddedSome
+	// ChangedValue
+	// RemovedSome
+}
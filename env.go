@@ -0,0 +1,23 @@
+package option
+
+import "os"
+
+// LookupEnv returns a Some holding the value of the environment variable named by the key
+// or returns a None if the variable is not present in the environment.
+//
+// A variable that is set to an empty value is returned as Some("").
+func LookupEnv(key string) Option[string] {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return None[string]()
+	}
+
+	return Some(v)
+}
+
+// LookupEnvAs looks up the environment variable named by the key and parses its value using the provided function.
+// If the variable is not present in the environment, it returns a None.
+// If the function returns an error, it propagates back (with a None).
+func LookupEnvAs[T any](key string, parse func(string) (T, error)) (Option[T], error) {
+	return TryMap(LookupEnv(key), parse)
+}
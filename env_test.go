@@ -0,0 +1,93 @@
+package option
+
+import (
+	"os"
+	"strconv"
+	"testing"
+)
+
+const testEnvKey = "GO_OPTION_TEST_ENV"
+
+func unsetEnv(t *testing.T, key string) {
+	t.Helper()
+
+	// Register a cleanup that restores the original value
+	t.Setenv(key, "")
+
+	if err := os.Unsetenv(key); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLookupEnv(t *testing.T) {
+	t.Run("Unset", func(t *testing.T) {
+		unsetEnv(t, testEnvKey)
+
+		v := LookupEnv(testEnvKey)
+
+		if !IsNone(v) {
+			t.Error("expected LookupEnv to return None, got:", v)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Setenv(testEnvKey, "")
+
+		v := LookupEnv(testEnvKey)
+
+		if !Equals(v, Some("")) {
+			t.Error("expected LookupEnv to return Some(\"\"), got:", v)
+		}
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		t.Setenv(testEnvKey, "hello")
+
+		v := LookupEnv(testEnvKey)
+
+		if !Equals(v, Some("hello")) {
+			t.Error("expected LookupEnv to return Some(\"hello\"), got:", v)
+		}
+	})
+}
+
+func TestLookupEnvAs(t *testing.T) {
+	t.Run("Unset", func(t *testing.T) {
+		unsetEnv(t, testEnvKey)
+
+		v, err := LookupEnvAs(testEnvKey, strconv.Atoi)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !IsNone(v) {
+			t.Error("expected LookupEnvAs to return None, got:", v)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Setenv(testEnvKey, "")
+
+		v, err := LookupEnvAs(testEnvKey, strconv.Atoi)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		if !IsNone(v) {
+			t.Error("expected LookupEnvAs to return None, got:", v)
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		t.Setenv(testEnvKey, "8080")
+
+		v, err := LookupEnvAs(testEnvKey, strconv.Atoi)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !Equals(v, Some(8080)) {
+			t.Error("expected LookupEnvAs to return Some(8080), got:", v)
+		}
+	})
+}
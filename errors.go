@@ -0,0 +1,19 @@
+//go:build go1.20
+
+package option
+
+import "errors"
+
+// JoinErrors returns an error that wraps the errors contained in os (see [errors.Join]).
+// It returns nil if none of the Options contain a (non-nil) error.
+func JoinErrors(os ...Option[error]) error {
+	var errs []error
+
+	for _, o := range os {
+		if IsSome(o) {
+			errs = append(errs, o.Value())
+		}
+	}
+
+	return errors.Join(errs...)
+}
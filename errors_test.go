@@ -0,0 +1,61 @@
+//go:build go1.20
+
+package option
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestJoinErrors(t *testing.T) {
+	err1 := errors.New("error 1")
+	err2 := errors.New("error 2")
+
+	t.Run("Empty", func(t *testing.T) {
+		err := JoinErrors()
+
+		if err != nil {
+			t.Error("expected JoinErrors to return nil, got:", err)
+		}
+	})
+
+	t.Run("AllNone", func(t *testing.T) {
+		err := JoinErrors(None[error](), None[error]())
+
+		if err != nil {
+			t.Error("expected JoinErrors to return nil, got:", err)
+		}
+	})
+
+	t.Run("NilError", func(t *testing.T) {
+		err := JoinErrors(Some[error](nil), None[error]())
+
+		if err != nil {
+			t.Error("expected JoinErrors to return nil, got:", err)
+		}
+	})
+
+	t.Run("One", func(t *testing.T) {
+		err := JoinErrors(None[error](), Some(err1))
+
+		if !errors.Is(err, err1) {
+			t.Error("expected JoinErrors to wrap error 1, got:", err)
+		}
+
+		if err.Error() != "error 1" {
+			t.Error("expected JoinErrors to return \"error 1\", got:", err)
+		}
+	})
+
+	t.Run("Several", func(t *testing.T) {
+		err := JoinErrors(Some(err1), None[error](), Some(err2))
+
+		if !errors.Is(err, err1) || !errors.Is(err, err2) {
+			t.Error("expected JoinErrors to wrap both errors, got:", err)
+		}
+
+		if err.Error() != "error 1\nerror 2" {
+			t.Errorf("expected JoinErrors to return %q, got: %q", "error 1\nerror 2", err.Error())
+		}
+	})
+}
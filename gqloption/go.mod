@@ -0,0 +1,17 @@
+module github.com/sagikazarmark/go-option/gqloption
+
+go 1.26
+
+require (
+	github.com/99designs/gqlgen v0.17.95
+	github.com/sagikazarmark/go-option v0.0.0-20261014104735-5ba0a0fb6eeb
+)
+
+require (
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/sosodev/duration v1.4.0 // indirect
+	github.com/vektah/gqlparser/v2 v2.5.37 // indirect
+	golang.org/x/sync v0.22.0 // indirect
+)
+
+replace github.com/sagikazarmark/go-option => ../
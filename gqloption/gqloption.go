@@ -0,0 +1,143 @@
+// Package gqloption provides GraphQL (gqlgen) support for optional values.
+//
+// It lives in its own module so that gqlgen does not become a dependency of the option package.
+package gqloption
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+// Option is an optional value that implements [graphql.Marshaler] and [graphql.Unmarshaler],
+// so it can be bound to nullable GraphQL scalars.
+//
+// A Some is serialized as the scalar, a None is serialized as GraphQL null.
+//
+// Option implements [option.Option], so it can be used with the rest of the option package.
+// The zero value is a None.
+type Option[T any] struct {
+	value T
+	valid bool
+}
+
+// From returns a new Option holding the same state as o.
+func From[T any](o option.Option[T]) Option[T] {
+	return Option[T]{
+		value: o.Value(),
+		valid: o.HasValue(),
+	}
+}
+
+// HasValue implements [option.Option].
+func (o Option[T]) HasValue() bool {
+	return o.valid
+}
+
+// Value implements [option.Option].
+func (o Option[T]) Value() T {
+	return o.value
+}
+
+// MarshalGQL implements [graphql.Marshaler].
+//
+// If the contained value implements [graphql.Marshaler] itself, the call is delegated to it.
+// Common scalar types use the built-in gqlgen marshalers, any other value is serialized as JSON.
+func (o Option[T]) MarshalGQL(w io.Writer) {
+	if !o.valid {
+		graphql.Null.MarshalGQL(w)
+
+		return
+	}
+
+	marshaler(o.value).MarshalGQL(w)
+}
+
+func marshaler(value any) graphql.Marshaler {
+	switch v := value.(type) {
+	case graphql.Marshaler:
+		return v
+
+	case string:
+		return graphql.MarshalString(v)
+
+	case bool:
+		return graphql.MarshalBoolean(v)
+
+	case int:
+		return graphql.MarshalInt(v)
+
+	case int32:
+		return graphql.MarshalInt32(v)
+
+	case int64:
+		return graphql.MarshalInt64(v)
+
+	case float64:
+		return graphql.MarshalFloat(v)
+	}
+
+	return graphql.MarshalAny(value)
+}
+
+// UnmarshalGQL implements [graphql.Unmarshaler].
+//
+// A nil input is unmarshaled as a None.
+// If the value type implements [graphql.Unmarshaler] itself, the call is delegated to it.
+func (o *Option[T]) UnmarshalGQL(v any) error {
+	if v == nil {
+		*o = Option[T]{}
+
+		return nil
+	}
+
+	var (
+		value T
+		err   error
+	)
+
+	switch p := any(&value).(type) {
+	case graphql.Unmarshaler:
+		err = p.UnmarshalGQL(v)
+
+	case *string:
+		*p, err = graphql.UnmarshalString(v)
+
+	case *bool:
+		*p, err = graphql.UnmarshalBoolean(v)
+
+	case *int:
+		*p, err = graphql.UnmarshalInt(v)
+
+	case *int32:
+		*p, err = graphql.UnmarshalInt32(v)
+
+	case *int64:
+		*p, err = graphql.UnmarshalInt64(v)
+
+	case *float64:
+		*p, err = graphql.UnmarshalFloat(v)
+
+	default:
+		var ok bool
+
+		value, ok = v.(T)
+		if !ok {
+			err = fmt.Errorf("%T is not a %T", v, value)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*o = Option[T]{
+		value: value,
+		valid: true,
+	}
+
+	return nil
+}
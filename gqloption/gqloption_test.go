@@ -0,0 +1,149 @@
+package gqloption
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+// money is a custom scalar that implements its own marshalers.
+type money struct {
+	cents int
+}
+
+func (m money) MarshalGQL(w io.Writer) {
+	_, _ = fmt.Fprintf(w, `"%d.%02d"`, m.cents/100, m.cents%100)
+}
+
+func (m *money) UnmarshalGQL(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("%T is not a string", v)
+	}
+
+	var units, cents int
+
+	if _, err := fmt.Sscanf(s, "%d.%d", &units, &cents); err != nil {
+		return err
+	}
+
+	m.cents = units*100 + cents
+
+	return nil
+}
+
+func marshal(m interface{ MarshalGQL(w io.Writer) }) string {
+	var buf bytes.Buffer
+
+	m.MarshalGQL(&buf)
+
+	return strings.TrimSpace(buf.String())
+}
+
+func TestOption_MarshalGQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		option   interface{ MarshalGQL(w io.Writer) }
+		expected string
+	}{
+		{"String", From(option.Some("hello")), `"hello"`},
+		{"Int", From(option.Some(42)), `42`},
+		{"Bool", From(option.Some(true)), `true`},
+		{"Float", From(option.Some(1.5)), `1.5`},
+		{"Custom", From(option.Some(money{1050})), `"10.50"`},
+		{"Any", From(option.Some([]int{1, 2})), `[1,2]`},
+		{"None", From(option.None[string]()), `null`},
+		{"Zero", Option[int]{}, `null`},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if v := marshal(test.option); v != test.expected {
+				t.Errorf("expected %s, got: %s", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestOption_UnmarshalGQL(t *testing.T) {
+	t.Run("Null", func(t *testing.T) {
+		o := From(option.Some("hello"))
+
+		if err := o.UnmarshalGQL(nil); err != nil {
+			t.Fatal(err)
+		}
+
+		if !option.IsNone[string](o) {
+			t.Error("expected null to unmarshal as None, got:", o)
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		var o Option[string]
+
+		if err := o.UnmarshalGQL("hello"); err != nil {
+			t.Fatal(err)
+		}
+
+		if !option.Equals[string](o, option.Some("hello")) {
+			t.Error("expected Some(\"hello\"), got:", o)
+		}
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		var o Option[int]
+
+		if err := o.UnmarshalGQL(json.Number("42")); err != nil {
+			t.Fatal(err)
+		}
+
+		if !option.Equals[int](o, option.Some(42)) {
+			t.Error("expected Some(42), got:", o)
+		}
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		var o Option[money]
+
+		if err := o.UnmarshalGQL("10.50"); err != nil {
+			t.Fatal(err)
+		}
+
+		if !option.Equals[money](o, option.Some(money{1050})) {
+			t.Error("expected Some(money{1050}), got:", o)
+		}
+	})
+
+	t.Run("Other", func(t *testing.T) {
+		var o Option[[]any]
+
+		if err := o.UnmarshalGQL([]any{"hello"}); err != nil {
+			t.Fatal(err)
+		}
+
+		if !option.IsSome[[]any](o) || len(o.Value()) != 1 {
+			t.Error("expected Some([hello]), got:", o)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var o Option[int]
+
+		if err := o.UnmarshalGQL("hello"); err == nil {
+			t.Error("expected an error for an invalid value")
+		}
+
+		var o2 Option[[]string]
+
+		if err := o2.UnmarshalGQL(42); err == nil {
+			t.Error("expected an error for a value of the wrong type")
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package option
+
+// FNV-1a parameters.
+const (
+	hashOffset uint64 = 14695981039346656037
+	hashPrime  uint64 = 1099511628211
+)
+
+// Hash returns a hash of o using the provided hash function for the contained value (if any).
+//
+// A None always hashes to the same constant.
+// A Some combines a presence tag with the hash of its value, so Some(zero) and None hash differently.
+func Hash[T comparable](o Option[T], hashT func(T) uint64) uint64 {
+	if IsNone(o) {
+		return hashOffset
+	}
+
+	h := hashOffset
+	h = (h ^ 1) * hashPrime
+
+	return (h ^ hashT(o.Value())) * hashPrime
+}
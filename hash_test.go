@@ -0,0 +1,47 @@
+package option
+
+import (
+	"hash/fnv"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	hashString := func(v string) uint64 {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(v))
+
+		return h.Sum64()
+	}
+
+	hashInt := func(v int) uint64 { return uint64(v) }
+
+	t.Run("None", func(t *testing.T) {
+		if Hash(None[string](), hashString) != Hash(None[string](), hashString) {
+			t.Error("expected None to hash consistently")
+		}
+
+		if Hash(None[string](), hashString) != Hash(None[int](), hashInt) {
+			t.Error("expected None to hash to the same constant regardless of the hash function")
+		}
+	})
+
+	t.Run("Some", func(t *testing.T) {
+		if Hash(Some("hello"), hashString) != Hash(Some("hello"), hashString) {
+			t.Error("expected equal Somes to hash consistently")
+		}
+
+		if Hash(Some("hello"), hashString) == Hash(Some("world"), hashString) {
+			t.Error("expected different Somes to hash differently")
+		}
+	})
+
+	t.Run("SomeZeroAndNone", func(t *testing.T) {
+		if Hash(Some(0), hashInt) == Hash(None[int](), hashInt) {
+			t.Error("expected Some(0) and None to hash differently")
+		}
+
+		if Hash(Some(""), hashString) == Hash(None[string](), hashString) {
+			t.Error("expected Some(\"\") and None to hash differently")
+		}
+	})
+}
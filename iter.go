@@ -0,0 +1,105 @@
+//go:build go1.23
+
+package option
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+)
+
+// CollectSeq consumes a sequence of Options and returns a Some holding all the contained values
+// if every Option in the sequence contains a value, otherwise returns a None.
+//
+// It stops consuming the sequence at the first None.
+func CollectSeq[T any](seq iter.Seq[Option[T]]) Option[[]T] {
+	var values []T
+
+	for o := range seq {
+		if IsNone(o) {
+			return None[[]T]()
+		}
+
+		values = append(values, o.Value())
+	}
+
+	return Some(values)
+}
+
+// ScanLines returns a sequence of the lines read from r:
+// a Some for every non-blank line and a None for every blank (empty or whitespace-only) line.
+//
+// The lines are read lazily, using a [bufio.Scanner].
+// If reading fails (or a line is too long for the scanner), the sequence yields a None with the error and stops.
+func ScanLines(r io.Reader) iter.Seq2[Option[string], error] {
+	return func(yield func(Option[string], error) bool) {
+		scanner := bufio.NewScanner(r)
+
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			o := Some(line)
+			if strings.TrimSpace(line) == "" {
+				o = None[string]()
+			}
+
+			if !yield(o, nil) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			yield(None[string](), err)
+		}
+	}
+}
+
+// DecodeJSONArray returns a sequence of the elements of the JSON array read from r:
+// a Some for every value and a None for every null.
+//
+// The elements are decoded lazily (one by one), using a [json.Decoder].
+// If the input is not a valid JSON array (or an element cannot be decoded into T), the sequence yields a None with the error and stops.
+func DecodeJSONArray[T any](r io.Reader) iter.Seq2[Option[T], error] {
+	return func(yield func(Option[T], error) bool) {
+		decoder := json.NewDecoder(r)
+
+		tok, err := decoder.Token()
+		if err != nil {
+			yield(None[T](), err)
+
+			return
+		}
+
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			yield(None[T](), fmt.Errorf("expected a JSON array, got: %v", tok))
+
+			return
+		}
+
+		for decoder.More() {
+			var f Field[T]
+
+			if err := decoder.Decode(&f); err != nil {
+				yield(None[T](), err)
+
+				return
+			}
+
+			o := None[T]()
+			if IsSome[T](f) {
+				o = Some(f.Value())
+			}
+
+			if !yield(o, nil) {
+				return
+			}
+		}
+
+		if _, err := decoder.Token(); err != nil {
+			yield(None[T](), err)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+//go:build go1.23
+
+package option
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestCollectSeq(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		seq := slices.Values([]Option[int]{Some(1), Some(2), Some(3)})
+
+		v := CollectSeq(seq)
+
+		if !IsSome(v) || !slices.Equal(v.Value(), []int{1, 2, 3}) {
+			t.Error("expected CollectSeq to return Some([1 2 3]), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		var consumed int
+
+		seq := func(yield func(Option[int]) bool) {
+			for _, o := range []Option[int]{Some(1), None[int](), Some(3)} {
+				consumed++
+
+				if !yield(o) {
+					return
+				}
+			}
+		}
+
+		v := CollectSeq(seq)
+
+		if !IsNone(v) {
+			t.Error("expected CollectSeq to return None, got:", v)
+		}
+
+		if consumed != 2 {
+			t.Error("expected CollectSeq to stop at the first None, consumed:", consumed)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		v := CollectSeq(slices.Values([]Option[int]{}))
+
+		if !IsSome(v) || len(v.Value()) != 0 {
+			t.Error("expected CollectSeq to return an empty Some, got:", v)
+		}
+	})
+}
+
+func TestScanLines(t *testing.T) {
+	r := strings.NewReader("hello\n\nworld\n  \nfoo")
+
+	var lines []Option[string]
+
+	for o, err := range ScanLines(r) {
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		lines = append(lines, o)
+	}
+
+	expected := []Option[string]{Some("hello"), None[string](), Some("world"), None[string](), Some("foo")}
+
+	if !equalSlices(lines, expected) {
+		t.Error("expected ScanLines to yield Somes for non-blank and Nones for blank lines, got:", lines)
+	}
+
+	t.Run("Break", func(t *testing.T) {
+		r := strings.NewReader("hello\nworld")
+
+		var lines []Option[string]
+
+		for o := range ScanLines(r) {
+			lines = append(lines, o)
+
+			break
+		}
+
+		if !equalSlices(lines, []Option[string]{Some("hello")}) {
+			t.Error("expected ScanLines to stop when the loop breaks, got:", lines)
+		}
+	})
+
+	errRead := errors.New("read failed")
+
+	tests := []struct {
+		name  string
+		input io.Reader
+		err   error
+		valid int
+	}{
+		{"ReadError", io.MultiReader(strings.NewReader("hello\n\n"), iotest.ErrReader(errRead)), errRead, 2},
+		{"TooLong", strings.NewReader("hello\n" + strings.Repeat("a", bufio.MaxScanTokenSize)), bufio.ErrTooLong, 1},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				valid int
+				errs  []error
+			)
+
+			for o, err := range ScanLines(test.input) {
+				if err != nil {
+					if !IsNone(o) {
+						t.Error("expected ScanLines to yield None with an error, got:", o)
+					}
+
+					errs = append(errs, err)
+
+					continue
+				}
+
+				valid++
+			}
+
+			if valid != test.valid {
+				t.Errorf("expected ScanLines to yield %d lines before the error, got: %d", test.valid, valid)
+			}
+
+			if len(errs) != 1 || !errors.Is(errs[0], test.err) {
+				t.Errorf("expected ScanLines to yield exactly one %v error, got: %v", test.err, errs)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONArray(t *testing.T) {
+	t.Run("Mixed", func(t *testing.T) {
+		var values []Option[int]
+
+		for o, err := range DecodeJSONArray[int](strings.NewReader(`[1, null, 3, null]`)) {
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			values = append(values, o)
+		}
+
+		expected := []Option[int]{Some(1), None[int](), Some(3), None[int]()}
+
+		if !equalSlices(values, expected) {
+			t.Errorf("expected DecodeJSONArray to yield %v, got: %v", expected, values)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		for o, err := range DecodeJSONArray[int](strings.NewReader(`[]`)) {
+			t.Error("expected DecodeJSONArray to yield nothing, got:", o, err)
+		}
+	})
+
+	t.Run("Break", func(t *testing.T) {
+		var values []Option[int]
+
+		for o := range DecodeJSONArray[int](strings.NewReader(`[1, 2, 3`)) {
+			values = append(values, o)
+
+			if len(values) == 2 {
+				break
+			}
+		}
+
+		if !equalSlices(values, []Option[int]{Some(1), Some(2)}) {
+			t.Error("expected DecodeJSONArray to stop after a break, got:", values)
+		}
+	})
+
+	tests := []struct {
+		name  string
+		input string
+		valid int
+	}{
+		{"Malformed", `[1, 2,, 3]`, 2},
+		{"Unterminated", `[1, 2`, 2},
+		{"WrongType", `[1, "hello"]`, 1},
+		{"NotAnArray", `{"hello": 1}`, 0},
+		{"EmptyInput", ``, 0},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				valid int
+				errs  []error
+			)
+
+			for o, err := range DecodeJSONArray[int](strings.NewReader(test.input)) {
+				if err != nil {
+					if !IsNone(o) {
+						t.Error("expected DecodeJSONArray to yield None with an error, got:", o)
+					}
+
+					errs = append(errs, err)
+
+					continue
+				}
+
+				valid++
+			}
+
+			if valid != test.valid {
+				t.Errorf("expected DecodeJSONArray to yield %d values before the error, got: %d", test.valid, valid)
+			}
+
+			if len(errs) != 1 {
+				t.Error("expected DecodeJSONArray to yield exactly one error, got:", errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,462 @@
+package option
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// MarshalJSON implements [json.Marshaler].
+//
+// A Some is encoded as its value (using the value's own MarshalJSON method, if any).
+func (s some[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.value)
+}
+
+// MarshalJSON implements [json.Marshaler].
+//
+// A None is always encoded as null (it is never omitted).
+func (none[T]) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// Field is an optional value that also tracks whether it was explicitly set.
+//
+// It is primarily useful for decoding JSON payloads with PATCH (eg. JSON Merge Patch) semantics,
+// where three states have to be distinguished:
+// - the field is absent: Field is a None and IsSet returns false ("don't touch")
+// - the field is null: Field is a None and IsSet returns true ("clear")
+// - the field has a value: Field is a Some and IsSet returns true
+//
+// Compared to a plain Option (which always encodes a None as null), an unset Field is omitted
+// from the encoded JSON when the struct field is tagged with omitzero (Go 1.24+).
+// A Field that is set to a None is encoded as null.
+//
+// Field implements Option, so it can be used with the rest of the package.
+// It also implements [encoding.TextMarshaler] and [encoding.TextUnmarshaler],
+// so it can be used as a map key in JSON objects (eg. map[Field[int]]string).
+// The zero value is an unset None.
+type Field[T any] struct {
+	value T
+	valid bool
+	set   bool
+}
+
+// NewField returns a new Field holding the same state as o and marked as set.
+func NewField[T any](o Option[T]) Field[T] {
+	return Field[T]{
+		value: o.Value(),
+		valid: o.HasValue(),
+		set:   true,
+	}
+}
+
+// HasValue implements Option.
+func (f Field[T]) HasValue() bool {
+	return f.valid
+}
+
+// Value implements Option.
+func (f Field[T]) Value() T {
+	return f.value
+}
+
+// IsSet returns true if the Field was explicitly set (eg. it was present in the decoded JSON, even if it was null).
+func (f Field[T]) IsSet() bool {
+	return f.set
+}
+
+// IsZero returns true if the Field is not set.
+//
+// It is used by encoding/json to omit unset Fields tagged with omitzero.
+func (f Field[T]) IsZero() bool {
+	return !f.set
+}
+
+// MarshalJSON implements [json.Marshaler].
+//
+// A Some is encoded as its value, a None is encoded as null.
+func (f Field[T]) MarshalJSON() ([]byte, error) {
+	if !f.valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(f.value)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+//
+// It is only called when the field is present in the decoded JSON, so a Field is always marked as set after decoding.
+// A null value is decoded as a None.
+//
+// The value is decoded with [json.Unmarshal], so the settings of the outer [json.Decoder] (eg. UseNumber) do not apply to it.
+// Use Field[json.Number] to preserve numbers in their textual form (eg. large integers).
+func (f *Field[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*f = Field[T]{set: true}
+
+		return nil
+	}
+
+	var value T
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*f = Field[T]{
+		value: value,
+		valid: true,
+		set:   true,
+	}
+
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+//
+// A None is encoded as an empty string.
+// A Some is encoded using the value's MarshalText method (if any), strings are encoded as is
+// and any other value is encoded as JSON (eg. numbers and booleans).
+//
+// States that would not round-trip return an error instead:
+// an unset Field (which would be indistinguishable from a None)
+// and a Some encoded as an empty string (eg. Some("")).
+// This prevents distinct map keys from being encoded as the same text.
+func (f Field[T]) MarshalText() ([]byte, error) {
+	if !f.set {
+		return nil, errUnsetFieldText
+	}
+
+	if !f.valid {
+		return []byte{}, nil
+	}
+
+	text, err := f.marshalValueText()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(text) == 0 {
+		return nil, errEmptyFieldText
+	}
+
+	return text, nil
+}
+
+var (
+	errUnsetFieldText = errors.New("an unset field cannot be encoded as text")
+	errEmptyFieldText = errors.New("a value encoded as an empty text cannot be distinguished from a none")
+)
+
+func (f Field[T]) marshalValueText() ([]byte, error) {
+	switch v := any(f.value).(type) {
+	case encoding.TextMarshaler:
+		return v.MarshalText()
+
+	case string:
+		return []byte(v), nil
+	}
+
+	return json.Marshal(f.value)
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// It is the inverse of MarshalText: an empty string is decoded as a None.
+func (f *Field[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*f = Field[T]{set: true}
+
+		return nil
+	}
+
+	var value T
+
+	switch v := any(&value).(type) {
+	case encoding.TextUnmarshaler:
+		if err := v.UnmarshalText(text); err != nil {
+			return err
+		}
+
+	case *string:
+		*v = string(text)
+
+	default:
+		if err := json.Unmarshal(text, &value); err != nil {
+			return err
+		}
+	}
+
+	*f = Field[T]{
+		value: value,
+		valid: true,
+		set:   true,
+	}
+
+	return nil
+}
+
+// Envelope is an optional value that can be decoded from JSON in either of the following forms:
+// - a bare value or null (like Field)
+// - an object wrapping the value: {"value": x, "present": true}
+//
+// The object form is only recognized when it contains a boolean "present" key and no keys other than "value" and "present"
+// (matched exactly, not case-insensitively).
+// When present is false, the Envelope is decoded as a None; when it is true, the "value" key is required.
+//
+// An Envelope is always encoded in the object form.
+//
+// Envelope implements Option, so it can be used with the rest of the package.
+// The zero value is a None.
+type Envelope[T any] struct {
+	value T
+	valid bool
+}
+
+// NewEnvelope returns a new Envelope holding the same state as o.
+func NewEnvelope[T any](o Option[T]) Envelope[T] {
+	return Envelope[T]{
+		value: o.Value(),
+		valid: o.HasValue(),
+	}
+}
+
+// HasValue implements Option.
+func (e Envelope[T]) HasValue() bool {
+	return e.valid
+}
+
+// Value implements Option.
+func (e Envelope[T]) Value() T {
+	return e.value
+}
+
+type envelope struct {
+	Value   json.RawMessage `json:"value"`
+	Present *bool           `json:"present"`
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (e Envelope[T]) MarshalJSON() ([]byte, error) {
+	value := []byte("null")
+
+	if e.valid {
+		var err error
+
+		value, err = json.Marshal(e.value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(envelope{
+		Value:   value,
+		Present: &e.valid,
+	})
+}
+
+var errMissingEnvelopeValue = errors.New("an envelope marked as present must contain a value")
+
+// decodeEnvelope decodes the object form of an Envelope.
+// Keys are matched exactly (unlike struct fields in encoding/json), so bare object values with similar keys are not mistaken for an envelope.
+func decodeEnvelope(data []byte) (present bool, value json.RawMessage, ok bool) {
+	var fields map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return false, nil, false
+	}
+
+	for key := range fields {
+		if key != "value" && key != "present" {
+			return false, nil, false
+		}
+	}
+
+	var p *bool
+
+	if err := json.Unmarshal(fields["present"], &p); err != nil || p == nil {
+		return false, nil, false
+	}
+
+	return *p, fields["value"], true
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (e *Envelope[T]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if len(data) > 0 && data[0] == '{' {
+		if present, value, ok := decodeEnvelope(data); ok {
+			if !present {
+				*e = Envelope[T]{}
+
+				return nil
+			}
+
+			if value == nil {
+				return errMissingEnvelopeValue
+			}
+
+			data = value
+		}
+	}
+
+	var f Field[T]
+
+	if err := f.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	*e = Envelope[T]{
+		value: f.value,
+		valid: f.valid,
+	}
+
+	return nil
+}
+
+// DefaultSentinel is the string a SentinelOption encodes a None as, unless a different sentinel is configured.
+const DefaultSentinel = "<<null>>"
+
+// SentinelOption is an optional value that encodes a None as a sentinel string (instead of null) in JSON.
+// It is meant to be used with (legacy) consumers that cannot handle null values.
+//
+// Decoding the sentinel (or null) yields a None.
+//
+// Note: json.Marshal escapes HTML characters (like the ones in DefaultSentinel) in its output.
+// Use a [json.Encoder] with HTML escaping disabled to emit the sentinel verbatim.
+//
+// Note: a Some holding a string equal to the sentinel does not survive a round-trip.
+//
+// SentinelOption implements Option, so it can be used with the rest of the package.
+// The zero value is a None using DefaultSentinel.
+type SentinelOption[T any] struct {
+	value    T
+	valid    bool
+	sentinel string
+}
+
+// NewSentinelOption returns a new SentinelOption holding the same state as o.
+// If sentinel is empty, DefaultSentinel is used.
+//
+// When decoding, the sentinel of the destination is used, so configure it before decoding into a SentinelOption:
+//
+//	o := option.NewSentinelOption(option.None[string](), "N/A")
+//	err := json.Unmarshal(data, &o)
+func NewSentinelOption[T any](o Option[T], sentinel string) SentinelOption[T] {
+	return SentinelOption[T]{
+		value:    o.Value(),
+		valid:    o.HasValue(),
+		sentinel: sentinel,
+	}
+}
+
+// HasValue implements Option.
+func (s SentinelOption[T]) HasValue() bool {
+	return s.valid
+}
+
+// Value implements Option.
+func (s SentinelOption[T]) Value() T {
+	return s.value
+}
+
+// Sentinel returns the string a None is encoded as.
+func (s SentinelOption[T]) Sentinel() string {
+	if s.sentinel == "" {
+		return DefaultSentinel
+	}
+
+	return s.sentinel
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (s SentinelOption[T]) MarshalJSON() ([]byte, error) {
+	if !s.valid {
+		var buf bytes.Buffer
+
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+
+		if err := encoder.Encode(s.Sentinel()); err != nil {
+			return nil, err
+		}
+
+		return bytes.TrimSpace(buf.Bytes()), nil
+	}
+
+	return json.Marshal(s.value)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (s *SentinelOption[T]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+
+		if str == s.Sentinel() {
+			*s = SentinelOption[T]{sentinel: s.sentinel}
+
+			return nil
+		}
+	}
+
+	var f Field[T]
+
+	if err := f.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	*s = SentinelOption[T]{
+		value:    f.value,
+		valid:    f.valid,
+		sentinel: s.sentinel,
+	}
+
+	return nil
+}
+
+// EncodeJSONArray writes os to w as a compact JSON array, encoding Nones as null and Somes as their contained values.
+//
+// Elements are encoded one by one, so (unlike json.Marshal) the whole array is never materialized in memory.
+// The output does not depend on the Option implementations (only on their contained values).
+func EncodeJSONArray[T any](w io.Writer, os []Option[T]) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	for i, o := range os {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+
+		value := []byte("null")
+
+		if IsSome(o) {
+			var err error
+
+			value, err = json.Marshal(o.Value())
+			if err != nil {
+				return err
+			}
+		}
+
+		if _, err := w.Write(value); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "]")
+
+	return err
+}
@@ -0,0 +1,32 @@
+//go:build go1.24
+
+package option
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestField_OmitZero(t *testing.T) {
+	type patch struct {
+		Name  Field[string] `json:"name,omitzero"`
+		Email Field[string] `json:"email,omitzero"`
+		Phone Field[string] `json:"phone,omitzero"`
+	}
+
+	p := patch{
+		Name:  NewField(Some("John")),
+		Email: NewField(None[string]()),
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const expected = `{"name":"John","email":null}`
+
+	if string(data) != expected {
+		t.Errorf("expected %s, got: %s", expected, data)
+	}
+}
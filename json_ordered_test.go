@@ -0,0 +1,24 @@
+//go:build go1.21
+
+package option
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeJSONArray_Ordered(t *testing.T) {
+	os := []Option[int]{NewOrdered(Some(1)), NewOrdered(None[int]()), None[int](), Some(4)}
+
+	var buf bytes.Buffer
+
+	if err := EncodeJSONArray(&buf, os); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	expected := string(marshalValues(t, os))
+
+	if buf.String() != expected {
+		t.Errorf("expected EncodeJSONArray to produce %s, got: %s", expected, buf.String())
+	}
+}
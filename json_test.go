@@ -0,0 +1,683 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOption_MarshalJSON(t *testing.T) {
+	type payload struct {
+		Name  Option[string] `json:"name"`
+		Email Option[string] `json:"email"`
+		Age   Option[int]    `json:"age,omitempty"`
+	}
+
+	p := payload{
+		Name:  Some("John"),
+		Email: None[string](),
+		Age:   None[int](),
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const expected = `{"name":"John","email":null,"age":null}`
+
+	if string(data) != expected {
+		t.Errorf("expected %s, got: %s", expected, data)
+	}
+}
+
+// money implements a custom JSON representation.
+type money struct {
+	cents int
+}
+
+func (m money) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%d.%02d"`, m.cents/100, m.cents%100)), nil
+}
+
+func TestOption_MarshalJSON_Delegates(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{"Option", Some(money{1050})},
+		{"Field", NewField(Some(money{1050}))},
+		{"Envelope", NewEnvelope(Some(money{1050}))},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(string(data), `"10.50"`) {
+				t.Error("expected the custom marshaler of the value to be used, got:", string(data))
+			}
+		})
+	}
+}
+
+func TestEncodeJSONArray(t *testing.T) {
+	tests := []struct {
+		name string
+		os   []Option[int]
+	}{
+		{"Nil", nil},
+		{"Empty", []Option[int]{}},
+		{"Single", []Option[int]{Some(1)}},
+		{"Mixed", []Option[int]{Some(1), None[int](), Some(3), None[int]()}},
+		{"Nones", []Option[int]{None[int](), None[int]()}},
+		{"Implementations", []Option[int]{NewField(Some(1)), NewEnvelope(None[int]()), NewSlot(Some(3))}},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			err := EncodeJSONArray(&buf, test.os)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			expected := "[]"
+
+			if len(test.os) > 0 {
+				expected = string(marshalValues(t, test.os))
+			}
+
+			if buf.String() != expected {
+				t.Errorf("expected EncodeJSONArray to produce %s, got: %s", expected, buf.String())
+			}
+		})
+	}
+}
+
+// marshalValues encodes the values contained in os (or nil) as a JSON array using json.Marshal.
+func marshalValues[T any](t *testing.T, os []Option[T]) []byte {
+	t.Helper()
+
+	values := make([]*T, 0, len(os))
+
+	for _, o := range os {
+		values = append(values, Map(o, func(v T) *T { return &v }).Value())
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return data
+}
+
+func TestField(t *testing.T) {
+	type patch struct {
+		Name Field[string] `json:"name"`
+	}
+
+	t.Run("Absent", func(t *testing.T) {
+		var p patch
+
+		if err := json.Unmarshal([]byte(`{}`), &p); err != nil {
+			t.Fatal(err)
+		}
+
+		if p.Name.IsSet() {
+			t.Error("expected an absent field not to be set")
+		}
+
+		if !IsNone[string](p.Name) {
+			t.Error("expected an absent field to be None, got:", p.Name)
+		}
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		var p patch
+
+		if err := json.Unmarshal([]byte(`{"name": null}`), &p); err != nil {
+			t.Fatal(err)
+		}
+
+		if !p.Name.IsSet() {
+			t.Error("expected a null field to be set")
+		}
+
+		if !IsNone[string](p.Name) {
+			t.Error("expected a null field to be None, got:", p.Name)
+		}
+	})
+
+	t.Run("Present", func(t *testing.T) {
+		var p patch
+
+		if err := json.Unmarshal([]byte(`{"name": "John"}`), &p); err != nil {
+			t.Fatal(err)
+		}
+
+		if !p.Name.IsSet() {
+			t.Error("expected a present field to be set")
+		}
+
+		if !Equals[string](p.Name, Some("John")) {
+			t.Error("expected a present field to be Some(\"John\"), got:", p.Name)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var p patch
+
+		if err := json.Unmarshal([]byte(`{"name": 1}`), &p); err == nil {
+			t.Error("expected an error for a value of the wrong type")
+		}
+	})
+
+	t.Run("Marshal", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			field    Field[string]
+			expected string
+		}{
+			{"Some", NewField(Some("John")), `{"name":"John"}`},
+			{"None", NewField(None[string]()), `{"name":null}`},
+			{"Unset", Field[string]{}, `{"name":null}`},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				data, err := json.Marshal(patch{Name: test.field})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if string(data) != test.expected {
+					t.Errorf("expected %s, got: %s", test.expected, data)
+				}
+			})
+		}
+	})
+}
+
+// TestField_Bool guards the three-valued boolean (unset/true/false) round-trip, eg. for feature flags.
+func TestField_Bool(t *testing.T) {
+	type flags struct {
+		Beta Field[bool] `json:"beta"`
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		expected Option[bool]
+		marshal  string
+	}{
+		{"Absent", `{}`, None[bool](), `{"beta":null}`},
+		{"Null", `{"beta":null}`, None[bool](), `{"beta":null}`},
+		{"True", `{"beta":true}`, Some(true), `{"beta":true}`},
+		{"False", `{"beta":false}`, Some(false), `{"beta":false}`},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			var f flags
+
+			if err := json.Unmarshal([]byte(test.data), &f); err != nil {
+				t.Fatal(err)
+			}
+
+			if !Equals[bool](f.Beta, test.expected) {
+				t.Errorf("expected %s to decode to %v, got: %v", test.data, test.expected, f.Beta)
+			}
+
+			data, err := json.Marshal(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(data) != test.marshal {
+				t.Errorf("expected %s, got: %s", test.marshal, data)
+			}
+
+			// A plain Option with the same state encodes the same way
+			data, err = json.Marshal(map[string]Option[bool]{"beta": test.expected})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(data) != test.marshal {
+				t.Errorf("expected %s, got: %s", test.marshal, data)
+			}
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		var f flags
+
+		if err := json.Unmarshal([]byte(`{"beta":"yes"}`), &f); err == nil {
+			t.Error("expected an error for a value of the wrong type")
+		}
+	})
+}
+
+func TestField_Number(t *testing.T) {
+	const data = `{"id":9007199254740993}`
+
+	var payload struct {
+		ID Field[json.Number] `json:"id"`
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&payload); err != nil {
+		t.Fatal(err)
+	}
+
+	if !Equals[json.Number](payload.ID, Some(json.Number("9007199254740993"))) {
+		t.Fatal("expected the number to be preserved, got:", payload.ID)
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(encoded) != data {
+		t.Errorf("expected %s, got: %s", data, encoded)
+	}
+
+	t.Run("Option", func(t *testing.T) {
+		encoded, err := json.Marshal(Some(json.Number("9007199254740993")))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(encoded) != "9007199254740993" {
+			t.Error("expected the number to be preserved, got:", string(encoded))
+		}
+	})
+}
+
+func TestField_Text(t *testing.T) {
+	t.Run("MapKey", func(t *testing.T) {
+		m := map[Field[int]]string{
+			NewField(Some(1)):     "one",
+			NewField(Some(2)):     "two",
+			NewField(None[int]()): "none",
+		}
+
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		const expected = `{"":"none","1":"one","2":"two"}`
+
+		if string(data) != expected {
+			t.Errorf("expected %s, got: %s", expected, data)
+		}
+
+		var decoded map[Field[int]]string
+
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		if len(decoded) != len(m) {
+			t.Fatal("expected the map to round-trip, got:", decoded)
+		}
+
+		for k, v := range m {
+			if decoded[k] != v {
+				t.Errorf("expected key %v to round-trip to %q, got: %q", k, v, decoded[k])
+			}
+		}
+	})
+
+	t.Run("MapKeyCollision", func(t *testing.T) {
+		tests := []struct {
+			name string
+			m    any
+		}{
+			{"UnsetAndNone", map[Field[int]]string{{}: "unset", NewField(None[int]()): "null"}},
+			{"EmptyStringAndNone", map[Field[string]]string{NewField(Some("")): "empty", NewField(None[string]()): "null"}},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				data, err := json.Marshal(test.m)
+				if err == nil {
+					t.Error("expected an error for keys that cannot be told apart, got:", string(data))
+				}
+			})
+		}
+	})
+
+	t.Run("Unset", func(t *testing.T) {
+		if _, err := (Field[int]{}).MarshalText(); err == nil {
+			t.Error("expected an error for an unset field")
+		}
+	})
+
+	t.Run("EmptyText", func(t *testing.T) {
+		if _, err := NewField(Some("")).MarshalText(); err == nil {
+			t.Error("expected an error for a value encoded as an empty text")
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		text, err := NewField(Some("hello")).MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(text) != "hello" {
+			t.Error("expected strings to be encoded as is, got:", string(text))
+		}
+
+		var f Field[string]
+
+		if err := f.UnmarshalText(text); err != nil {
+			t.Fatal(err)
+		}
+
+		if !Equals[string](f, Some("hello")) {
+			t.Error("expected the value to round-trip, got:", f)
+		}
+	})
+
+	t.Run("TextMarshaler", func(t *testing.T) {
+		ts := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		text, err := NewField(Some(ts)).MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(text) != "2022-01-01T00:00:00Z" {
+			t.Error("expected the value's MarshalText method to be used, got:", string(text))
+		}
+
+		var f Field[time.Time]
+
+		if err := f.UnmarshalText(text); err != nil {
+			t.Fatal(err)
+		}
+
+		if !EqualsEq[time.Time](f, Some(ts)) {
+			t.Error("expected the value to round-trip, got:", f)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var f Field[int]
+
+		if err := f.UnmarshalText([]byte("hello")); err == nil {
+			t.Error("expected an error for an invalid value")
+		}
+	})
+}
+
+func ExampleField() {
+	var patch struct {
+		Name  Field[string] `json:"name"`
+		Email Field[string] `json:"email"`
+		Phone Field[string] `json:"phone"`
+	}
+
+	_ = json.Unmarshal([]byte(`{"name": "John", "email": null}`), &patch)
+
+	fmt.Println(patch.Name.IsSet(), UnwrapOr[string](patch.Name, "none"))
+	fmt.Println(patch.Email.IsSet(), UnwrapOr[string](patch.Email, "none"))
+	fmt.Println(patch.Phone.IsSet(), UnwrapOr[string](patch.Phone, "none"))
+
+	// Output:
+	// true John
+	// true none
+	// false none
+}
+
+func TestEnvelope(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			data     string
+			expected Option[int]
+		}{
+			{"Bare", `1`, Some(1)},
+			{"Null", `null`, None[int]()},
+			{"Wrapped", `{"value": 1, "present": true}`, Some(1)},
+			{"WrappedNotPresent", `{"value": null, "present": false}`, None[int]()},
+			{"WrappedWithoutValue", `{"present": false}`, None[int]()},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				var e Envelope[int]
+
+				if err := json.Unmarshal([]byte(test.data), &e); err != nil {
+					t.Fatal(err)
+				}
+
+				if !Equals[int](e, test.expected) {
+					t.Errorf("expected %v, got: %v", test.expected, e)
+				}
+			})
+		}
+	})
+
+	t.Run("UnmarshalObjectValue", func(t *testing.T) {
+		type point struct {
+			X int `json:"x"`
+			Y int `json:"y"`
+		}
+
+		tests := []struct {
+			name string
+			data string
+		}{
+			{"Bare", `{"x": 1, "y": 2}`},
+			{"Wrapped", `{"value": {"x": 1, "y": 2}, "present": true}`},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				var e Envelope[point]
+
+				if err := json.Unmarshal([]byte(test.data), &e); err != nil {
+					t.Fatal(err)
+				}
+
+				if !Equals[point](e, Some(point{1, 2})) {
+					t.Error("expected Some(point{1, 2}), got:", e)
+				}
+			})
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var e Envelope[int]
+
+		if err := json.Unmarshal([]byte(`{"value": "hello", "present": true}`), &e); err == nil {
+			t.Error("expected an error for a value of the wrong type")
+		}
+	})
+
+	t.Run("MissingValue", func(t *testing.T) {
+		var e Envelope[int]
+
+		err := json.Unmarshal([]byte(`{"present": true}`), &e)
+		if !errors.Is(err, errMissingEnvelopeValue) {
+			t.Error("expected a missing value error, got:", err)
+		}
+	})
+
+	t.Run("UnmarshalSimilarKeys", func(t *testing.T) {
+		type value struct {
+			Present bool `json:"Present"`
+			Value   int  `json:"Value"`
+		}
+
+		var e Envelope[value]
+
+		if err := json.Unmarshal([]byte(`{"Present": true, "Value": 1}`), &e); err != nil {
+			t.Fatal(err)
+		}
+
+		if !Equals[value](e, Some(value{true, 1})) {
+			t.Error("expected the object to be decoded as a bare value, got:", e)
+		}
+	})
+
+	t.Run("Marshal", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			envelope Envelope[int]
+			expected string
+		}{
+			{"Some", NewEnvelope(Some(1)), `{"value":1,"present":true}`},
+			{"None", NewEnvelope(None[int]()), `{"value":null,"present":false}`},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				data, err := json.Marshal(test.envelope)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if string(data) != test.expected {
+					t.Errorf("expected %s, got: %s", test.expected, data)
+				}
+			})
+		}
+	})
+}
+
+func TestSentinelOption(t *testing.T) {
+	type record struct {
+		Name SentinelOption[string] `json:"name"`
+		Age  SentinelOption[int]    `json:"age"`
+	}
+
+	t.Run("Marshal", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			record   record
+			expected string
+		}{
+			{"Some", record{NewSentinelOption(Some("John"), ""), NewSentinelOption(Some(30), "")}, `{"name":"John","age":30}`},
+			{"None", record{NewSentinelOption(None[string](), ""), NewSentinelOption(None[int](), "N/A")}, `{"name":"<<null>>","age":"N/A"}`},
+			{"Zero", record{}, `{"name":"<<null>>","age":"<<null>>"}`},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				var buf strings.Builder
+
+				encoder := json.NewEncoder(&buf)
+				encoder.SetEscapeHTML(false)
+
+				if err := encoder.Encode(test.record); err != nil {
+					t.Fatal(err)
+				}
+
+				if data := strings.TrimSpace(buf.String()); data != test.expected {
+					t.Errorf("expected %s, got: %s", test.expected, data)
+				}
+			})
+		}
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		tests := []struct {
+			name string
+			data string
+			want record
+		}{
+			{"Some", `{"name":"John","age":30}`, record{NewSentinelOption(Some("John"), ""), NewSentinelOption(Some(30), "")}},
+			{"Sentinel", `{"name":"<<null>>","age":"<<null>>"}`, record{}},
+			{"Null", `{"name":null,"age":null}`, record{}},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				var r record
+
+				if err := json.Unmarshal([]byte(test.data), &r); err != nil {
+					t.Fatal(err)
+				}
+
+				if !Equals[string](r.Name, test.want.Name) || !Equals[int](r.Age, test.want.Age) {
+					t.Errorf("expected %s to decode to %v, got: %v", test.data, test.want, r)
+				}
+			})
+		}
+	})
+
+	t.Run("CustomSentinel", func(t *testing.T) {
+		o := NewSentinelOption(Some("John"), "N/A")
+
+		if err := json.Unmarshal([]byte(`"N/A"`), &o); err != nil {
+			t.Fatal(err)
+		}
+
+		if !IsNone[string](o) {
+			t.Error("expected the custom sentinel to decode to None, got:", o)
+		}
+
+		data, err := json.Marshal(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(data) != `"N/A"` {
+			t.Error("expected the custom sentinel to survive decoding, got:", string(data))
+		}
+
+		if err := json.Unmarshal([]byte(`"<<null>>"`), &o); err != nil {
+			t.Fatal(err)
+		}
+
+		if !Equals[string](o, Some(DefaultSentinel)) {
+			t.Error("expected the default sentinel to decode to a Some with a custom sentinel, got:", o)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var r record
+
+		if err := json.Unmarshal([]byte(`{"age":"thirty"}`), &r); err == nil {
+			t.Error("expected an error for a value of the wrong type")
+		}
+	})
+}
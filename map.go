@@ -0,0 +1,115 @@
+package option
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MapEntry is a view into a single entry of a map, which may either be present or absent.
+//
+// It is inspired by the entry API of Rust's map types:
+// https://doc.rust-lang.org/std/collections/hash_map/enum.Entry.html
+type MapEntry[K comparable, V any] struct {
+	m     map[K]V
+	key   K
+	value Option[V]
+}
+
+// Entry returns the entry for key in m for in-place manipulation.
+//
+// The map must not be nil if the entry is going to be inserted.
+func Entry[K comparable, V any](m map[K]V, key K) MapEntry[K, V] {
+	e := MapEntry[K, V]{
+		m:     m,
+		key:   key,
+		value: None[V](),
+	}
+
+	if v, ok := m[key]; ok {
+		e.value = Some(v)
+	}
+
+	return e
+}
+
+// Key returns the key of the entry.
+func (e MapEntry[K, V]) Key() K {
+	return e.key
+}
+
+// Option returns the value of the entry if it is present in the map.
+func (e MapEntry[K, V]) Option() Option[V] {
+	return e.value
+}
+
+// OrInsert inserts v into the map if the entry is absent and returns the value of the entry.
+func (e MapEntry[K, V]) OrInsert(v V) V {
+	return e.OrInsertWith(func() V { return v })
+}
+
+// OrInsertWith inserts the value computed by the provided function into the map if the entry is absent and returns the value of the entry.
+func (e MapEntry[K, V]) OrInsertWith(f func() V) V {
+	if IsSome(e.value) {
+		return e.value.Value()
+	}
+
+	v := f()
+
+	e.m[e.key] = v
+
+	return v
+}
+
+// AndModify calls the provided function with a pointer to the value of the entry if it is present in the map and stores the modified value.
+// It returns the (updated) entry, so it can be followed by OrInsert:
+//
+//	option.Entry(counts, word).AndModify(func(v *int) { *v++ }).OrInsert(1)
+func (e MapEntry[K, V]) AndModify(f func(v *V)) MapEntry[K, V] {
+	if IsNone(e.value) {
+		return e
+	}
+
+	v := e.value.Value()
+
+	f(&v)
+
+	e.m[e.key] = v
+	e.value = Some(v)
+
+	return e
+}
+
+// MapToOptions applies the provided function to every value of m and returns a new map of the results under the same keys.
+func MapToOptions[K comparable, V any, W any](m map[K]V, f func(v V) Option[W]) map[K]Option[W] {
+	mapped := make(map[K]Option[W], len(m))
+
+	for k, v := range m {
+		mapped[k] = f(v)
+	}
+
+	return mapped
+}
+
+// RequireAll returns an error (wrapping ErrNoValue) for every Option in fields that does not contain a value (or is nil).
+// The errors are named after the keys and ordered by key.
+//
+// It is meant to be used for "required field" checks, eg. in form validation.
+func RequireAll(fields map[string]Option[any]) []error {
+	names := make([]string, 0, len(fields))
+
+	for name := range fields {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var errs []error
+
+	for _, name := range names {
+		if o := fields[name]; o == nil || IsNone(o) {
+			errs = append(errs, fmt.Errorf("field %q: %w", name, ErrNoValue))
+		}
+	}
+
+	return errs
+}
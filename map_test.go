@@ -0,0 +1,204 @@
+package option
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestEntry(t *testing.T) {
+	t.Run("Present", func(t *testing.T) {
+		e := Entry(map[string]int{"hello": 1}, "hello")
+
+		if e.Key() != "hello" {
+			t.Error("expected the entry key to be \"hello\", got:", e.Key())
+		}
+
+		if !Equals(e.Option(), Some(1)) {
+			t.Error("expected the entry to be Some(1), got:", e.Option())
+		}
+	})
+
+	t.Run("Absent", func(t *testing.T) {
+		e := Entry(map[string]int{}, "hello")
+
+		if !IsNone(e.Option()) {
+			t.Error("expected the entry to be None, got:", e.Option())
+		}
+	})
+}
+
+func TestMapEntry_OrInsert(t *testing.T) {
+	t.Run("Absent", func(t *testing.T) {
+		m := map[string]int{}
+
+		v := Entry(m, "hello").OrInsert(1)
+
+		if v != 1 {
+			t.Error("expected OrInsert to return the inserted value, got:", v)
+		}
+
+		if m["hello"] != 1 {
+			t.Error("expected OrInsert to insert the value, got:", m)
+		}
+	})
+
+	t.Run("Present", func(t *testing.T) {
+		m := map[string]int{"hello": 2}
+
+		v := Entry(m, "hello").OrInsert(1)
+
+		if v != 2 {
+			t.Error("expected OrInsert to return the existing value, got:", v)
+		}
+
+		if m["hello"] != 2 {
+			t.Error("expected OrInsert not to overwrite the existing value, got:", m)
+		}
+	})
+}
+
+func TestMapEntry_OrInsertWith(t *testing.T) {
+	t.Run("Absent", func(t *testing.T) {
+		m := map[string]int{}
+
+		v := Entry(m, "hello").OrInsertWith(func() int { return 1 })
+
+		if v != 1 || m["hello"] != 1 {
+			t.Error("expected OrInsertWith to insert the computed value, got:", m)
+		}
+	})
+
+	t.Run("Present", func(t *testing.T) {
+		m := map[string]int{"hello": 2}
+
+		v := Entry(m, "hello").OrInsertWith(func() int {
+			t.Error("expected OrInsertWith not to call the function")
+
+			return 1
+		})
+
+		if v != 2 || m["hello"] != 2 {
+			t.Error("expected OrInsertWith to keep the existing value, got:", m)
+		}
+	})
+}
+
+func TestMapEntry_AndModify(t *testing.T) {
+	inc := func(v *int) { *v++ }
+
+	t.Run("Present", func(t *testing.T) {
+		m := map[string]int{"hello": 1}
+
+		e := Entry(m, "hello").AndModify(inc)
+
+		if m["hello"] != 2 {
+			t.Error("expected AndModify to modify the existing value, got:", m)
+		}
+
+		if !Equals(e.Option(), Some(2)) {
+			t.Error("expected AndModify to return the updated entry, got:", e.Option())
+		}
+
+		if v := e.OrInsert(10); v != 2 {
+			t.Error("expected OrInsert to return the modified value, got:", v)
+		}
+	})
+
+	t.Run("Absent", func(t *testing.T) {
+		m := map[string]int{}
+
+		e := Entry(m, "hello").AndModify(inc)
+
+		if len(m) != 0 {
+			t.Error("expected AndModify not to insert anything, got:", m)
+		}
+
+		if v := e.OrInsert(1); v != 1 || m["hello"] != 1 {
+			t.Error("expected OrInsert to insert the value, got:", m)
+		}
+	})
+}
+
+func TestMapToOptions(t *testing.T) {
+	m := map[string]string{
+		"a": "1",
+		"b": "hello",
+		"c": "3",
+	}
+
+	v := MapToOptions(m, func(v string) Option[int] {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return None[int]()
+		}
+
+		return Some(i)
+	})
+
+	expected := map[string]Option[int]{
+		"a": Some(1),
+		"b": None[int](),
+		"c": Some(3),
+	}
+
+	if len(v) != len(expected) {
+		t.Fatalf("expected MapToOptions to return %v, got: %v", expected, v)
+	}
+
+	for k, o := range expected {
+		if !Equals(v[k], o) {
+			t.Errorf("expected key %q to map to %v, got: %v", k, o, v[k])
+		}
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		v := MapToOptions(map[string]string(nil), func(v string) Option[int] { return None[int]() })
+
+		if v == nil || len(v) != 0 {
+			t.Error("expected MapToOptions to return an empty map, got:", v)
+		}
+	})
+}
+
+func TestRequireAll(t *testing.T) {
+	t.Run("AllPresent", func(t *testing.T) {
+		errs := RequireAll(map[string]Option[any]{
+			"name":  Some[any]("John"),
+			"email": Some[any]("john@example.com"),
+		})
+
+		if len(errs) != 0 {
+			t.Error("expected RequireAll to return no errors, got:", errs)
+		}
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		errs := RequireAll(map[string]Option[any]{
+			"name":  Some[any]("John"),
+			"email": None[any](),
+			"age":   None[any](),
+			"phone": nil,
+		})
+
+		expected := []string{
+			`field "age": option does not contain any value`,
+			`field "email": option does not contain any value`,
+			`field "phone": option does not contain any value`,
+		}
+
+		if len(errs) != len(expected) {
+			t.Fatalf("expected RequireAll to return %d errors, got: %v", len(expected), errs)
+		}
+
+		for i, err := range errs {
+			if err.Error() != expected[i] {
+				t.Errorf("expected error %q, got: %q", expected[i], err.Error())
+			}
+
+			if !errors.Is(err, ErrNoValue) {
+				t.Error("expected the error to wrap ErrNoValue, got:", err)
+			}
+		}
+	})
+}
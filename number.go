@@ -0,0 +1,70 @@
+package option
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum returns the sum of the values contained in os.
+// Nones are skipped (treated as 0), so an empty or all-None slice yields 0.
+func Sum[T Number](os []Option[T]) T {
+	var sum T
+
+	for _, o := range os {
+		if IsSome(o) {
+			sum += o.Value()
+		}
+	}
+
+	return sum
+}
+
+// Product returns the product of the values contained in os.
+// Nones are skipped (treated as 1), so an empty or all-None slice yields 1.
+func Product[T Number](os []Option[T]) T {
+	var product T = 1
+
+	for _, o := range os {
+		if IsSome(o) {
+			product *= o.Value()
+		}
+	}
+
+	return product
+}
+
+// Average returns the arithmetic mean of the values contained in os (computed in float64)
+// or returns a None if none of the Options contain a value.
+func Average[T Number](os []Option[T]) Option[float64] {
+	var (
+		sum   float64
+		count int
+	)
+
+	for _, o := range os {
+		if IsSome(o) {
+			sum += float64(o.Value())
+			count++
+		}
+	}
+
+	if count == 0 {
+		return None[float64]()
+	}
+
+	return Some(sum / float64(count))
+}
+
+// ConvertNumber converts the contained value (if any) to another numeric type or returns a None.
+//
+// The conversion follows Go's conversion rules between numeric types (narrowing conversions may truncate or overflow).
+// The target type comes first, so the source type can be inferred: ConvertNumber[int64](o).
+func ConvertNumber[To Number, From Number](o Option[From]) Option[To] {
+	if IsNone(o) {
+		return None[To]()
+	}
+
+	return Some(To(o.Value()))
+}
@@ -0,0 +1,143 @@
+package option
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	t.Run("Mixed", func(t *testing.T) {
+		v := Sum([]Option[int]{Some(1), None[int](), Some(2), Some(3)})
+
+		if v != 6 {
+			t.Error("expected Sum to return 6, got:", v)
+		}
+	})
+
+	t.Run("AllNone", func(t *testing.T) {
+		v := Sum([]Option[float64]{None[float64](), None[float64]()})
+
+		if v != 0 {
+			t.Error("expected Sum to return 0, got:", v)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		v := Sum[int](nil)
+
+		if v != 0 {
+			t.Error("expected Sum to return 0, got:", v)
+		}
+	})
+}
+
+func ExampleSum() {
+	os := []Option[int]{Some(1), None[int](), Some(2)}
+
+	fmt.Println(Sum(os))
+
+	// Output:
+	// 3
+}
+
+func TestProduct(t *testing.T) {
+	t.Run("Mixed", func(t *testing.T) {
+		v := Product([]Option[int]{Some(2), None[int](), Some(3), Some(4)})
+
+		if v != 24 {
+			t.Error("expected Product to return 24, got:", v)
+		}
+	})
+
+	t.Run("AllNone", func(t *testing.T) {
+		v := Product([]Option[float64]{None[float64](), None[float64]()})
+
+		if v != 1 {
+			t.Error("expected Product to return 1, got:", v)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		v := Product[int](nil)
+
+		if v != 1 {
+			t.Error("expected Product to return 1, got:", v)
+		}
+	})
+}
+
+func TestAverage(t *testing.T) {
+	t.Run("Mixed", func(t *testing.T) {
+		v := Average([]Option[int]{Some(1), None[int](), Some(2), None[int]()})
+
+		if !Equals(v, Some(1.5)) {
+			t.Error("expected Average to return Some(1.5), got:", v)
+		}
+	})
+
+	t.Run("Single", func(t *testing.T) {
+		v := Average([]Option[uint8]{None[uint8](), Some[uint8](200)})
+
+		if !Equals(v, Some(200.0)) {
+			t.Error("expected Average to return Some(200), got:", v)
+		}
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		v := Average([]Option[uint8]{Some[uint8](200), Some[uint8](250)})
+
+		if !Equals(v, Some(225.0)) {
+			t.Error("expected Average to return Some(225), got:", v)
+		}
+	})
+
+	t.Run("AllNone", func(t *testing.T) {
+		v := Average([]Option[float64]{None[float64](), None[float64]()})
+
+		if !IsNone(v) {
+			t.Error("expected Average to return None, got:", v)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		v := Average[int](nil)
+
+		if !IsNone(v) {
+			t.Error("expected Average to return None, got:", v)
+		}
+	})
+}
+
+func TestConvertNumber(t *testing.T) {
+	t.Run("Widening", func(t *testing.T) {
+		v := ConvertNumber[int64](Some[int32](42))
+
+		if !Equals(v, Some[int64](42)) {
+			t.Error("expected ConvertNumber to return Some(42), got:", v)
+		}
+	})
+
+	t.Run("Narrowing", func(t *testing.T) {
+		v := ConvertNumber[int8](Some[int64](300))
+
+		if !Equals(v, Some[int8](44)) {
+			t.Error("expected ConvertNumber to return Some(44), got:", v)
+		}
+	})
+
+	t.Run("FloatToInt", func(t *testing.T) {
+		v := ConvertNumber[int](Some(3.7))
+
+		if !Equals(v, Some(3)) {
+			t.Error("expected ConvertNumber to return Some(3), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v := ConvertNumber[int64](None[int32]())
+
+		if !IsNone(v) {
+			t.Error("expected ConvertNumber to return None, got:", v)
+		}
+	})
+}
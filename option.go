@@ -4,6 +4,15 @@
 // https://doc.rust-lang.org/std/option/index.html
 package option
 
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrNoValue is returned (or used as a panic value) when an Option does not contain a value.
+var ErrNoValue = errors.New("option does not contain any value")
+
 // Option represents an optional value.
 // It either contains a value or it does not.
 //
@@ -29,6 +38,34 @@ func Some[T any](value T) Option[T] {
 	}
 }
 
+// SomeNonNil returns a new Option that contains a value, unless the value is nil, in which case it returns a None.
+//
+// Unlike Some, it guards against wrapping nil interfaces, pointers, maps, slices, functions and channels.
+// The nil check uses reflection, so SomeNonNil is slower than Some.
+func SomeNonNil[T any](value T) Option[T] {
+	if isNil(value) {
+		return None[T]()
+	}
+
+	return Some(value)
+}
+
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		return rv.IsNil()
+
+	default:
+		return false
+	}
+}
+
 // IsSome returns true if o contains a value.
 func IsSome[T any](o Option[T]) bool {
 	return o.HasValue()
@@ -68,10 +105,27 @@ func (none[T]) Value() T {
 	return value
 }
 
-// Unwrap returns the contained value or panics.
+// Unwrap returns the contained value or panics with ErrNoValue.
 func Unwrap[T any](o Option[T]) T {
 	if IsNone(o) {
-		panic("option does not contain any value")
+		panic(ErrNoValue)
+	}
+
+	return o.Value()
+}
+
+// Must returns the contained value or panics with ErrNoValue.
+//
+// It is an alias of Unwrap for people more familiar with Go's Must naming convention.
+func Must[T any](o Option[T]) T {
+	return Unwrap(o)
+}
+
+// Unwrapf returns the contained value or panics with an error wrapping ErrNoValue.
+// The error message is formatted according to a format specifier, so it can include contextual information (eg. identifiers).
+func Unwrapf[T any](o Option[T], format string, args ...any) T {
+	if IsNone(o) {
+		panic(fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), ErrNoValue))
 	}
 
 	return o.Value()
@@ -86,11 +140,32 @@ func UnwrapOr[T any](o Option[T], d T) T {
 	return o.Value()
 }
 
+// WithDefault returns a function that returns the contained value (if any) of an Option or returns the provided default value.
+//
+// It is equivalent to calling UnwrapOr with the same default value.
+func WithDefault[T any](d T) func(o Option[T]) T {
+	return func(o Option[T]) T {
+		return UnwrapOr(o, d)
+	}
+}
+
 // UnwrapOrDefault returns the contained value (if any) or returns the default value of the type.
 func UnwrapOrDefault[T any](o Option[T]) T {
 	return o.Value()
 }
 
+// UnwrapOrNil returns the contained value (if any) as an interface value or returns an untyped nil.
+//
+// It is meant to be used when the result is passed on as an interface value (eg. to an API accepting any),
+// where UnwrapOrDefault would return a typed nil for a None of a pointer (or interface) type, which is not equal to nil.
+func UnwrapOrNil[T any](o Option[T]) any {
+	if IsNone(o) {
+		return nil
+	}
+
+	return o.Value()
+}
+
 // UnwrapOrElse returns the contained value (if any) or computes it from the provided default function.
 func UnwrapOrElse[T any](o Option[T], d func() T) T {
 	if IsNone(o) {
@@ -100,6 +175,78 @@ func UnwrapOrElse[T any](o Option[T], d func() T) T {
 	return o.Value()
 }
 
+// UnwrapOrElseTry returns the contained value (if any) or computes it from the provided default function.
+// If the function returns an error, it propagates back.
+func UnwrapOrElseTry[T any](o Option[T], d func() (T, error)) (T, error) {
+	if IsNone(o) {
+		return d()
+	}
+
+	return o.Value(), nil
+}
+
+// UnwrapOrRecomputeZero returns the contained value (if any) unless it is the default value of the type,
+// otherwise computes it from the provided function.
+//
+// It requires a comparable type to detect the default value.
+// It is useful when a Some holding the zero value (eg. an empty string) is a placeholder that should be recomputed.
+func UnwrapOrRecomputeZero[T comparable](o Option[T], compute func() T) T {
+	var zero T
+
+	if IsNone(o) || o.Value() == zero {
+		return compute()
+	}
+
+	return o.Value()
+}
+
+// UnwrapAll2 returns the contained values if both Options contain a value.
+// Otherwise it returns the default values of the types and an error (wrapping ErrNoValue) identifying the first argument without a value.
+func UnwrapAll2[A any, B any](a Option[A], b Option[B]) (A, B, error) {
+	var (
+		va A
+		vb B
+	)
+
+	if IsNone(a) {
+		return va, vb, noValueArgument(1)
+	}
+
+	if IsNone(b) {
+		return va, vb, noValueArgument(2)
+	}
+
+	return a.Value(), b.Value(), nil
+}
+
+// UnwrapAll3 returns the contained values if all three Options contain a value.
+// Otherwise it returns the default values of the types and an error (wrapping ErrNoValue) identifying the first argument without a value.
+func UnwrapAll3[A any, B any, C any](a Option[A], b Option[B], c Option[C]) (A, B, C, error) {
+	var (
+		va A
+		vb B
+		vc C
+	)
+
+	if IsNone(a) {
+		return va, vb, vc, noValueArgument(1)
+	}
+
+	if IsNone(b) {
+		return va, vb, vc, noValueArgument(2)
+	}
+
+	if IsNone(c) {
+		return va, vb, vc, noValueArgument(3)
+	}
+
+	return a.Value(), b.Value(), c.Value(), nil
+}
+
+func noValueArgument(position int) error {
+	return fmt.Errorf("argument %d: %w", position, ErrNoValue)
+}
+
 // Map applies the provided function to the contained value (if any) or returns a None.
 func Map[T any, U any](o Option[T], f func(v T) U) Option[U] {
 	if IsNone(o) {
@@ -124,6 +271,20 @@ func TryMap[T any, U any](o Option[T], f func(v T) (U, error)) (Option[U], error
 	return Some(v), nil
 }
 
+// Transpose collapses an optional value that may have failed into the idiomatic (value, error) form:
+// if err is not nil, it returns a None and err, otherwise it returns o and nil.
+//
+// It is useful for wrapping functions returning (Option[T], error) to guarantee that a failed call never yields a Some:
+//
+//	o, err := option.Transpose(lookup(key))
+func Transpose[T any](o Option[T], err error) (Option[T], error) {
+	if err != nil {
+		return None[T](), err
+	}
+
+	return o, nil
+}
+
 // MapOr applies the provided function to the contained value (if any) or returns the provided default value.
 func MapOr[T any, U any](o Option[T], d U, f func(v T) U) U {
 	if IsNone(o) {
@@ -133,6 +294,17 @@ func MapOr[T any, U any](o Option[T], d U, f func(v T) U) U {
 	return f(o.Value())
 }
 
+// MapOrZero applies the provided function to the contained value (if any) or returns the default value of the type.
+func MapOrZero[T any, U any](o Option[T], f func(v T) U) U {
+	if IsNone(o) {
+		var u U
+
+		return u
+	}
+
+	return f(o.Value())
+}
+
 // TryMapOr applies the provided function to the contained value (if any) or returns the provided default value.
 // If the function returns an error, it propagates back.
 func TryMapOr[T any, U any](o Option[T], d U, f func(v T) (U, error)) (U, error) {
@@ -161,6 +333,45 @@ func TryMapOrElse[T any, U any](o Option[T], d func() U, f func(v T) (U, error))
 	return f(o.Value())
 }
 
+// MapOrError applies the provided function to the contained value (if any) or returns the provided error.
+func MapOrError[T any, U any](o Option[T], err error, f func(v T) U) (U, error) {
+	if IsNone(o) {
+		var u U
+
+		return u, err
+	}
+
+	return f(o.Value()), nil
+}
+
+// MapOrErrorElse applies the provided function to the contained value (if any) or returns the error computed by the provided error function.
+func MapOrErrorElse[T any, U any](o Option[T], err func() error, f func(v T) U) (U, error) {
+	if IsNone(o) {
+		var u U
+
+		return u, err()
+	}
+
+	return f(o.Value()), nil
+}
+
+// MapPtr applies the provided function to the contained pointer and returns a Some holding the value the result points to.
+// It returns a None if o does not contain a value, the contained pointer is nil or the function returns nil.
+//
+// It makes dereferencing (possibly nil) nested pointers safe.
+func MapPtr[T any, U any](o Option[*T], f func(v *T) *U) Option[U] {
+	if IsNone(o) || o.Value() == nil {
+		return None[U]()
+	}
+
+	u := f(o.Value())
+	if u == nil {
+		return None[U]()
+	}
+
+	return Some(*u)
+}
+
 // And returns o2 if o contains a value.
 func And[T any](o Option[T], o2 Option[T]) Option[T] {
 	if IsNone(o) {
@@ -179,6 +390,22 @@ func AndThen[T any](o Option[T], f func(v T) Option[T]) Option[T] {
 	return f(o.Value())
 }
 
+// Chain applies the provided functions to the contained value (if any) one after the other (see AndThen)
+// and returns the final value or returns a None as soon as a function returns a None.
+//
+// It makes same-type chains read left to right:
+//
+//	option.Chain(o, f, g, h) // instead of option.AndThen(option.AndThen(option.AndThen(o, f), g), h)
+//
+// Use AndThen (or Map) for steps that change the type of the value.
+func Chain[T any](o Option[T], fs ...func(v T) Option[T]) Option[T] {
+	for _, f := range fs {
+		o = AndThen(o, f)
+	}
+
+	return o
+}
+
 // Or returns o if it contains a value, returns o2 otherwise.
 func Or[T any](o Option[T], o2 Option[T]) Option[T] {
 	if IsNone(o) {
@@ -197,6 +424,51 @@ func OrElse[T any](o Option[T], f func() Option[T]) Option[T] {
 	return o
 }
 
+// OrMap returns o if it contains a value or returns a Some holding the result of calling the provided function.
+// Unlike OrElse, the returned Option always contains a value.
+func OrMap[T any](o Option[T], f func() T) Option[T] {
+	if IsNone(o) {
+		return Some(f())
+	}
+
+	return o
+}
+
+// OrZero returns o if it contains a value or returns a Some holding the default value of the type.
+// The returned Option always contains a value.
+func OrZero[T any](o Option[T]) Option[T] {
+	if IsNone(o) {
+		var value T
+
+		return Some(value)
+	}
+
+	return o
+}
+
+// CoalesceMap returns the value contained in a mapped by fa if a contains a value,
+// otherwise returns the value contained in b mapped by fb if b contains a value, otherwise returns a None.
+//
+// It is useful for falling back between sources of different types that can be reduced to a common type.
+func CoalesceMap[A any, B any, R any](a Option[A], fa func(v A) R, b Option[B], fb func(v B) R) Option[R] {
+	if IsSome(a) {
+		return Some(fa(a.Value()))
+	}
+
+	return Map(b, fb)
+}
+
+// FirstOr returns the value contained in the first Option of os that contains a value or returns the provided default value.
+func FirstOr[T any](d T, os ...Option[T]) T {
+	for _, o := range os {
+		if IsSome(o) {
+			return o.Value()
+		}
+	}
+
+	return d
+}
+
 // Xor returns o or o2 if exactly one of them contains a value, otherwise returns a None.
 func Xor[T any](o Option[T], o2 Option[T]) Option[T] {
 	if IsSome(o) && IsNone(o2) {
@@ -223,6 +495,101 @@ func Filter[T any](o Option[T], f func(T) bool) Option[T] {
 	return o
 }
 
+// FilterFn returns a function that returns an Option if it contains a value and the provided predicate applied to the contained value returns true.
+//
+// It is equivalent to calling Filter with the same predicate, so it can be used to build reusable (and composable) filters.
+func FilterFn[T any](pred func(T) bool) func(o Option[T]) Option[T] {
+	return func(o Option[T]) Option[T] {
+		return Filter(o, pred)
+	}
+}
+
+// Validate returns o if it contains a value and the provided validation function applied to the contained value returns no error.
+// If the function returns an error, it propagates back (with a None).
+// The function is not called if o does not contain a value.
+//
+// It can be used to validate a value in the middle of a chain of calls and abort the chain with an error.
+func Validate[T any](o Option[T], pred func(T) error) (Option[T], error) {
+	if IsNone(o) {
+		return None[T](), nil
+	}
+
+	if err := pred(o.Value()); err != nil {
+		return None[T](), err
+	}
+
+	return o, nil
+}
+
+// ForEach calls the provided function with the contained value (if any).
+//
+// It is meant to be used at the end of a chain of calls for side effects.
+func ForEach[T any](o Option[T], f func(T)) {
+	if IsNone(o) {
+		return
+	}
+
+	f(o.Value())
+}
+
+// IfSome calls the provided function with the contained value (if any).
+//
+// It is an alias of ForEach that reads better as a conditional in imperative code.
+func IfSome[T any](o Option[T], f func(T)) {
+	ForEach(o, f)
+}
+
+// IfNone calls the provided function if o does not contain a value.
+func IfNone[T any](o Option[T], f func()) {
+	if IsSome(o) {
+		return
+	}
+
+	f()
+}
+
+// InspectLog logs the contained value (or "none" if there is none) using the provided logger and format, then returns o.
+//
+// The format receives a single argument, so it should use a verb that works with both a value and a string (eg. %v).
+// Any logger with a Printf method (eg. [log.Logger]) can be used.
+func InspectLog[T any](o Option[T], logger interface{ Printf(string, ...any) }, format string) Option[T] {
+	if IsNone(o) {
+		logger.Printf(format, "none")
+	} else {
+		logger.Printf(format, o.Value())
+	}
+
+	return o
+}
+
+// ContainsBy returns true if o contains a value and the provided predicate applied to the contained value returns true.
+func ContainsBy[T any](o Option[T], pred func(T) bool) bool {
+	if IsNone(o) {
+		return false
+	}
+
+	return pred(o.Value())
+}
+
+// Satisfies returns true if o does not contain a value or all the provided predicates return true for the contained value.
+//
+// A None is considered vacuously valid: it models an optional value that has to be valid when it is present.
+func Satisfies[T any](o Option[T], preds ...func(T) bool) bool {
+	if IsNone(o) {
+		return true
+	}
+
+	v := o.Value()
+
+	for _, pred := range preds {
+		if !pred(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals checks if two values are equal to each other according to the following:
 // - Two Nones are always equal
 // - Two Somes are equal if their values are equal
@@ -239,3 +606,40 @@ func Equals[T comparable](o1 Option[T], o2 Option[T]) bool {
 
 	return o1.Value() == o2.Value()
 }
+
+// WeakEquals checks if the values (or the default values of the type) of two Options are equal to each other.
+//
+// Unlike Equals, WeakEquals ignores presence: a Some holding the default value of the type is equal to a None
+// (eg. Some(0) and None are equal, while Some(5) and None are not).
+func WeakEquals[T comparable](o1 Option[T], o2 Option[T]) bool {
+	return UnwrapOrDefault(o1) == UnwrapOrDefault(o2)
+}
+
+// AllEqual checks if all the provided Options are equal to each other (according to Equals).
+// Empty and single-element inputs are always equal.
+func AllEqual[T comparable](os ...Option[T]) bool {
+	for i := 1; i < len(os); i++ {
+		if !Equals(os[0], os[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualsEq checks if two values are equal to each other according to the following:
+// - Two Nones are always equal
+// - Two Somes are equal if the Equal method of the first value reports them equal
+//
+// It is useful for types that know how to compare themselves (eg. [time.Time]).
+func EqualsEq[T interface{ Equal(T) bool }](o1 Option[T], o2 Option[T]) bool {
+	if IsSome(o1) != IsSome(o2) {
+		return false
+	}
+
+	if IsNone(o1) && IsNone(o2) {
+		return true
+	}
+
+	return o1.Value().Equal(o2.Value())
+}
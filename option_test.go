@@ -3,7 +3,9 @@ package option
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func TestSome(t *testing.T) {
@@ -31,6 +33,57 @@ func ExampleSome() {
 	// hello
 }
 
+func TestSomeNonNil(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		err := errors.New("something went wrong")
+
+		o := SomeNonNil(err)
+
+		if !IsSome(o) || o.Value() != err {
+			t.Error("expected SomeNonNil to return Some, got:", o)
+		}
+	})
+
+	t.Run("ZeroValue", func(t *testing.T) {
+		o := SomeNonNil(0)
+
+		if !Equals(o, Some(0)) {
+			t.Error("expected SomeNonNil to return Some(0), got:", o)
+		}
+	})
+
+	t.Run("EmptySlice", func(t *testing.T) {
+		o := SomeNonNil([]string{})
+
+		if !IsSome(o) {
+			t.Error("expected SomeNonNil to return Some, got:", o)
+		}
+	})
+
+	tests := []struct {
+		name string
+		o    Option[any]
+	}{
+		{"Interface", Map(SomeNonNil[error](nil), func(v error) any { return v })},
+		{"Pointer", Map(SomeNonNil[*int](nil), func(v *int) any { return v })},
+		{"Map", Map(SomeNonNil[map[string]int](nil), func(v map[string]int) any { return v })},
+		{"Slice", Map(SomeNonNil[[]string](nil), func(v []string) any { return v })},
+		{"Func", Map(SomeNonNil[func()](nil), func(v func()) any { return v })},
+		{"Chan", Map(SomeNonNil[chan int](nil), func(v chan int) any { return v })},
+		{"NilPointerInInterface", SomeNonNil[any]((*int)(nil))},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if !IsNone(test.o) {
+				t.Error("expected SomeNonNil to return None, got:", test.o)
+			}
+		})
+	}
+}
+
 func TestIsSome(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		if !IsSome(Some("hello")) {
@@ -122,7 +175,16 @@ func TestUnwrap(t *testing.T) {
 			v := recover()
 
 			if v == nil {
-				t.Error("expected Unwrap to panic on None")
+				t.Fatal("expected Unwrap to panic on None")
+			}
+
+			err, ok := v.(error)
+			if !ok {
+				t.Fatal("expected Unwrap to panic with an error, got:", v)
+			}
+
+			if !errors.Is(err, ErrNoValue) {
+				t.Error("expected Unwrap to panic with ErrNoValue, got:", err)
 			}
 		}()
 
@@ -140,6 +202,74 @@ func ExampleUnwrap() {
 	// hello
 }
 
+func TestMust(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v := Must(Some("hello"))
+
+		if v != "hello" {
+			t.Error("expected Must to return the contained value, got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		defer func() {
+			v := recover()
+
+			if v == nil {
+				t.Fatal("expected Must to panic on None")
+			}
+
+			err, ok := v.(error)
+			if !ok {
+				t.Fatal("expected Must to panic with an error, got:", v)
+			}
+
+			if !errors.Is(err, ErrNoValue) {
+				t.Error("expected Must to panic with ErrNoValue, got:", err)
+			}
+		}()
+
+		Must(None[string]())
+	})
+}
+
+func TestUnwrapf(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v := Unwrapf(Some("hello"), "user %d", 42)
+
+		if v != "hello" {
+			t.Error("expected Unwrapf to return the contained value, got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		defer func() {
+			v := recover()
+
+			if v == nil {
+				t.Fatal("expected Unwrapf to panic on None")
+			}
+
+			err, ok := v.(error)
+			if !ok {
+				t.Fatal("expected Unwrapf to panic with an error, got:", v)
+			}
+
+			if !errors.Is(err, ErrNoValue) {
+				t.Error("expected Unwrapf to panic with ErrNoValue, got:", err)
+			}
+
+			const expected = "user 42 has no email: option does not contain any value"
+
+			if err.Error() != expected {
+				t.Errorf("expected Unwrapf to panic with message %q, got: %q", expected, err.Error())
+			}
+		}()
+
+		Unwrapf(None[string](), "user %d has no %s", 42, "email")
+	})
+}
+
 func TestUnwrapOr(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		v := UnwrapOr(Some("hello"), "world")
@@ -170,6 +300,37 @@ func ExampleUnwrapOr() {
 	// world
 }
 
+func TestWithDefault(t *testing.T) {
+	unwrap := WithDefault("world")
+
+	t.Run("Some", func(t *testing.T) {
+		v := unwrap(Some("hello"))
+
+		if v != "hello" {
+			t.Error("expected WithDefault to return the contained value, got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v := unwrap(None[string]())
+
+		if v != "world" {
+			t.Error("expected WithDefault to return the provided value, got:", v)
+		}
+	})
+}
+
+func ExampleWithDefault() {
+	getPort := WithDefault(8080)
+
+	fmt.Println(getPort(Some(80)))
+	fmt.Println(getPort(None[int]()))
+
+	// Output:
+	// 80
+	// 8080
+}
+
 func TestUnwrapOrDefault(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		v := UnwrapOrDefault(Some("hello"))
@@ -200,6 +361,36 @@ func ExampleUnwrapOrDefault() {
 	//
 }
 
+func TestUnwrapOrNil(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v := UnwrapOrNil(Some("hello"))
+
+		if v != "hello" {
+			t.Error("expected UnwrapOrNil to return the contained value, got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[*int]()
+
+		if v := any(UnwrapOrDefault(o)); v == nil {
+			t.Error("expected UnwrapOrDefault to return a typed nil")
+		}
+
+		if v := UnwrapOrNil(o); v != nil {
+			t.Errorf("expected UnwrapOrNil to return an untyped nil, got: %#v", v)
+		}
+	})
+
+	t.Run("SomeTypedNil", func(t *testing.T) {
+		v := UnwrapOrNil(Some[*int](nil))
+
+		if p, ok := v.(*int); v == nil || !ok || p != nil {
+			t.Errorf("expected UnwrapOrNil to return the contained typed nil, got: %#v", v)
+		}
+	})
+}
+
 func TestUnwrapOrElse(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		v := UnwrapOrElse(Some("hello"), func() string { return "world" })
@@ -230,6 +421,161 @@ func ExampleUnwrapOrElse() {
 	// world
 }
 
+func TestUnwrapOrElseTry(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v, err := UnwrapOrElseTry(Some("hello"), func() (string, error) {
+			t.Error("expected UnwrapOrElseTry not to call the function on Some")
+
+			return "world", nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if v != "hello" {
+			t.Error("expected UnwrapOrElseTry to return the contained value, got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		t.Run("OK", func(t *testing.T) {
+			v, err := UnwrapOrElseTry(None[string](), func() (string, error) { return "world", nil })
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if v != "world" {
+				t.Error("expected UnwrapOrElseTry to return the computed value, got:", v)
+			}
+		})
+
+		t.Run("Error", func(t *testing.T) {
+			e := errors.New("error")
+
+			_, err := UnwrapOrElseTry(None[string](), func() (string, error) { return "", e })
+			if err != e {
+				t.Error("expected UnwrapOrElseTry to return error, got:", err)
+			}
+		})
+	})
+}
+
+func TestUnwrapOrRecomputeZero(t *testing.T) {
+	compute := func() string { return "world" }
+
+	tests := []struct {
+		name     string
+		o        Option[string]
+		expected string
+	}{
+		{"Some", Some("hello"), "hello"},
+		{"SomeZero", Some(""), "world"},
+		{"None", None[string](), "world"},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := UnwrapOrRecomputeZero(test.o, compute)
+
+			if v != test.expected {
+				t.Errorf("expected UnwrapOrRecomputeZero to return %q, got: %q", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestUnwrapAll2(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		a, b, err := UnwrapAll2(Some("hello"), Some(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a != "hello" || b != 1 {
+			t.Errorf("expected UnwrapAll2 to return the contained values, got: %v, %v", a, b)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			a        Option[string]
+			b        Option[int]
+			expected string
+		}{
+			{"First", None[string](), Some(1), "argument 1: option does not contain any value"},
+			{"Second", Some("hello"), None[int](), "argument 2: option does not contain any value"},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				a, b, err := UnwrapAll2(test.a, test.b)
+				if !errors.Is(err, ErrNoValue) {
+					t.Fatal("expected UnwrapAll2 to return ErrNoValue, got:", err)
+				}
+
+				if err.Error() != test.expected {
+					t.Error("unexpected error message:", err)
+				}
+
+				if a != "" || b != 0 {
+					t.Errorf("expected UnwrapAll2 to return the type default values, got: %v, %v", a, b)
+				}
+			})
+		}
+	})
+}
+
+func TestUnwrapAll3(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		a, b, c, err := UnwrapAll3(Some("hello"), Some(1), Some(true))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a != "hello" || b != 1 || c != true {
+			t.Errorf("expected UnwrapAll3 to return the contained values, got: %v, %v, %v", a, b, c)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			a        Option[string]
+			b        Option[int]
+			c        Option[bool]
+			expected string
+		}{
+			{"First", None[string](), Some(1), Some(true), "argument 1: option does not contain any value"},
+			{"Second", Some("hello"), None[int](), Some(true), "argument 2: option does not contain any value"},
+			{"Third", Some("hello"), Some(1), None[bool](), "argument 3: option does not contain any value"},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				a, b, c, err := UnwrapAll3(test.a, test.b, test.c)
+				if !errors.Is(err, ErrNoValue) {
+					t.Fatal("expected UnwrapAll3 to return ErrNoValue, got:", err)
+				}
+
+				if err.Error() != test.expected {
+					t.Error("unexpected error message:", err)
+				}
+
+				if a != "" || b != 0 || c != false {
+					t.Errorf("expected UnwrapAll3 to return the type default values, got: %v, %v, %v", a, b, c)
+				}
+			})
+		}
+	})
+}
+
 func TestMap(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		o := Some("hello")
@@ -318,6 +664,47 @@ func TestTryMap(t *testing.T) {
 	})
 }
 
+func TestTranspose(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		t.Run("OK", func(t *testing.T) {
+			o := Some("hello")
+
+			v, err := Transpose(o, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !Equals(v, o) {
+				t.Error("expected Transpose to return Some(\"hello\"), got:", v)
+			}
+		})
+
+		t.Run("Error", func(t *testing.T) {
+			e := errors.New("error")
+
+			v, err := Transpose(Some("hello"), e)
+			if err != e {
+				t.Error("expected Transpose to return error, got:", err)
+			}
+
+			if !IsNone(v) {
+				t.Error("expected Transpose to return None, got:", v)
+			}
+		})
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v, err := Transpose(None[string](), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !IsNone(v) {
+			t.Error("expected Transpose to return None, got:", v)
+		}
+	})
+}
+
 func TestMapOr(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		o := Some("hello")
@@ -340,6 +727,24 @@ func TestMapOr(t *testing.T) {
 	})
 }
 
+func TestMapOrZero(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v := MapOrZero(Some("hello"), func(v string) int { return len(v) })
+
+		if v != 5 {
+			t.Error("expected MapOrZero to return the mapped value, got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v := MapOrZero(None[string](), func(v string) int { return len(v) })
+
+		if v != 0 {
+			t.Error("expected MapOrZero to return the default value, got:", v)
+		}
+	})
+}
+
 func TestTryMapOr(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		t.Run("OK", func(t *testing.T) {
@@ -494,62 +899,256 @@ func TestTryMapOrElse(t *testing.T) {
 	})
 }
 
-func TestAnd(t *testing.T) {
+func TestMapOrError(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		o := Some("hello")
-		o2 := Some("world")
 
-		v := And(o, o2)
+		v, err := MapOrError(o, errors.New("error"), func(v string) int { return len(v) })
+		if err != nil {
+			t.Fatal(err)
+		}
 
-		if !Equals(v, o2) {
-			t.Error("expected And to return Some(\"world\"), got:", v)
+		if v != 5 {
+			t.Error("expected MapOrError to return 5, got:", v)
 		}
 	})
 
 	t.Run("None", func(t *testing.T) {
 		o := None[string]()
-		o2 := Some("world")
 
-		v := And(o, o2)
+		e := errors.New("error")
 
-		if !IsNone(v) {
-			t.Error("expected And to return None, got:", v)
+		v, err := MapOrError(o, e, func(v string) int {
+			t.Error("expected MapOrError not to call the function on None")
+
+			return len(v)
+		})
+		if err != e {
+			t.Error("expected MapOrError to return error, got:", err)
+		}
+
+		if v != 0 {
+			t.Error("expected MapOrError to return 0, got:", v)
 		}
 	})
 }
 
-func TestAndThen(t *testing.T) {
+func TestMapOrErrorElse(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		o := Some("hello")
 
-		v := AndThen(o, func(v string) Option[string] {
-			r := []rune(v)
-			for i, j := 0, len(r)-1; i < len(r)/2; i, j = i+1, j-1 {
-				r[i], r[j] = r[j], r[i]
-			}
-			return Some(string(r))
-		})
+		v, err := MapOrErrorElse(
+			o,
+			func() error {
+				t.Error("expected MapOrErrorElse not to call the error function on Some")
+
+				return errors.New("error")
+			},
+			func(v string) int { return len(v) },
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-		if !Equals(v, Some("olleh")) {
-			t.Error("expected AndThen to return Some(\"olleh\"), got:", v)
+		if v != 5 {
+			t.Error("expected MapOrErrorElse to return 5, got:", v)
 		}
 	})
 
 	t.Run("None", func(t *testing.T) {
 		o := None[string]()
 
-		v := AndThen(o, func(v string) Option[string] {
-			r := []rune(v)
-			for i, j := 0, len(r)-1; i < len(r)/2; i, j = i+1, j-1 {
-				r[i], r[j] = r[j], r[i]
-			}
-			return Some(string(r))
-		})
+		e := errors.New("error")
 
-		if !IsNone(v) {
-			t.Error("expected AndThen to return None, got:", v)
+		v, err := MapOrErrorElse(
+			o,
+			func() error { return e },
+			func(v string) int {
+				t.Error("expected MapOrErrorElse not to call the function on None")
+
+				return len(v)
+			},
+		)
+		if err != e {
+			t.Error("expected MapOrErrorElse to return error, got:", err)
 		}
-	})
+
+		if v != 0 {
+			t.Error("expected MapOrErrorElse to return 0, got:", v)
+		}
+	})
+}
+
+func TestMapPtr(t *testing.T) {
+	type address struct {
+		City *string
+	}
+
+	type user struct {
+		Address *address
+	}
+
+	city := func(u *user) *string {
+		if u.Address == nil {
+			return nil
+		}
+
+		return u.Address.City
+	}
+
+	t.Run("Some", func(t *testing.T) {
+		budapest := "Budapest"
+
+		v := MapPtr(Some(&user{Address: &address{City: &budapest}}), city)
+
+		if !Equals(v, Some("Budapest")) {
+			t.Error("expected MapPtr to return Some(\"Budapest\"), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v := MapPtr(None[*user](), city)
+
+		if !IsNone(v) {
+			t.Error("expected MapPtr to return None, got:", v)
+		}
+	})
+
+	t.Run("NilPointer", func(t *testing.T) {
+		v := MapPtr(Some[*user](nil), func(u *user) *string {
+			t.Error("expected MapPtr not to call the function on a nil pointer")
+
+			return city(u)
+		})
+
+		if !IsNone(v) {
+			t.Error("expected MapPtr to return None, got:", v)
+		}
+	})
+
+	t.Run("NilResult", func(t *testing.T) {
+		v := MapPtr(Some(&user{}), city)
+
+		if !IsNone(v) {
+			t.Error("expected MapPtr to return None, got:", v)
+		}
+	})
+}
+
+func TestAnd(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := Some("hello")
+		o2 := Some("world")
+
+		v := And(o, o2)
+
+		if !Equals(v, o2) {
+			t.Error("expected And to return Some(\"world\"), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[string]()
+		o2 := Some("world")
+
+		v := And(o, o2)
+
+		if !IsNone(v) {
+			t.Error("expected And to return None, got:", v)
+		}
+	})
+}
+
+func TestAndThen(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := Some("hello")
+
+		v := AndThen(o, func(v string) Option[string] {
+			r := []rune(v)
+			for i, j := 0, len(r)-1; i < len(r)/2; i, j = i+1, j-1 {
+				r[i], r[j] = r[j], r[i]
+			}
+			return Some(string(r))
+		})
+
+		if !Equals(v, Some("olleh")) {
+			t.Error("expected AndThen to return Some(\"olleh\"), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[string]()
+
+		v := AndThen(o, func(v string) Option[string] {
+			r := []rune(v)
+			for i, j := 0, len(r)-1; i < len(r)/2; i, j = i+1, j-1 {
+				r[i], r[j] = r[j], r[i]
+			}
+			return Some(string(r))
+		})
+
+		if !IsNone(v) {
+			t.Error("expected AndThen to return None, got:", v)
+		}
+	})
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+
+	step := func(name string, ok bool) func(v string) Option[string] {
+		return func(v string) Option[string] {
+			calls = append(calls, name)
+
+			if !ok {
+				return None[string]()
+			}
+
+			return Some(v + name)
+		}
+	}
+
+	t.Run("Some", func(t *testing.T) {
+		calls = nil
+
+		v := Chain(Some("x"), step("a", true), step("b", true), step("c", true))
+
+		if !Equals(v, Some("xabc")) {
+			t.Error("expected Chain to return Some(\"xabc\"), got:", v)
+		}
+	})
+
+	t.Run("NoneInTheMiddle", func(t *testing.T) {
+		calls = nil
+
+		v := Chain(Some("x"), step("a", true), step("b", false), step("c", true))
+
+		if !IsNone(v) {
+			t.Error("expected Chain to return None, got:", v)
+		}
+
+		if len(calls) != 2 {
+			t.Error("expected Chain to stop calling functions after a None, got calls:", calls)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		calls = nil
+
+		v := Chain(None[string](), step("a", true))
+
+		if !IsNone(v) || len(calls) != 0 {
+			t.Error("expected Chain to return None without calling any function, got:", v)
+		}
+	})
+
+	t.Run("NoFunctions", func(t *testing.T) {
+		v := Chain(Some("x"))
+
+		if !Equals(v, Some("x")) {
+			t.Error("expected Chain to return Some(\"x\"), got:", v)
+		}
+	})
 }
 
 func TestOr(t *testing.T) {
@@ -598,6 +1197,111 @@ func TestOrElse(t *testing.T) {
 	})
 }
 
+func TestOrMap(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := Some("hello")
+
+		v := OrMap(o, func() string {
+			t.Error("expected OrMap not to call the function on Some")
+
+			return "world"
+		})
+
+		if !Equals(v, o) {
+			t.Error("expected OrMap to return Some(\"hello\"), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[string]()
+
+		v := OrMap(o, func() string { return "world" })
+
+		if !Equals(v, Some("world")) {
+			t.Error("expected OrMap to return Some(\"world\"), got:", v)
+		}
+	})
+}
+
+func TestOrZero(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := Some("hello")
+
+		v := OrZero(o)
+
+		if !Equals(v, o) {
+			t.Error("expected OrZero to return Some(\"hello\"), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[string]()
+
+		v := OrZero(o)
+
+		if !Equals(v, Some("")) {
+			t.Error("expected OrZero to return Some(\"\"), got:", v)
+		}
+	})
+}
+
+func TestCoalesceMap(t *testing.T) {
+	fa := func(v int) string { return strconv.Itoa(v) }
+	fb := func(v time.Duration) string { return v.String() }
+
+	tests := []struct {
+		name     string
+		a        Option[int]
+		b        Option[time.Duration]
+		expected Option[string]
+	}{
+		{"SomeSome", Some(1), Some(time.Second), Some("1")},
+		{"SomeNone", Some(1), None[time.Duration](), Some("1")},
+		{"NoneSome", None[int](), Some(time.Second), Some("1s")},
+		{"NoneNone", None[int](), None[time.Duration](), None[string]()},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := CoalesceMap(test.a, fa, test.b, fb)
+
+			if !Equals(v, test.expected) {
+				t.Errorf("expected CoalesceMap to return %v, got: %v", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestFirstOr(t *testing.T) {
+	n := None[string]()
+
+	tests := []struct {
+		name     string
+		os       []Option[string]
+		expected string
+	}{
+		{"First", []Option[string]{Some("a"), Some("b"), n}, "a"},
+		{"Middle", []Option[string]{n, Some("b"), Some("c")}, "b"},
+		{"Last", []Option[string]{n, n, Some("c")}, "c"},
+		{"AllNone", []Option[string]{n, n}, "default"},
+		{"Empty", nil, "default"},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := FirstOr("default", test.os...)
+
+			if v != test.expected {
+				t.Errorf("expected FirstOr to return %q, got: %q", test.expected, v)
+			}
+		})
+	}
+}
+
 func TestXor(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		o := Some("hello")
@@ -669,6 +1373,248 @@ func TestFilter(t *testing.T) {
 	})
 }
 
+func TestFilterFn(t *testing.T) {
+	nonEmpty := FilterFn(func(v string) bool { return v != "" })
+	short := FilterFn(func(v string) bool { return len(v) < 10 })
+
+	tests := []struct {
+		name     string
+		o        Option[string]
+		expected Option[string]
+	}{
+		{"Pass", Some("hello"), Some("hello")},
+		{"FailFirst", Some(""), None[string]()},
+		{"FailSecond", Some("hello world"), None[string]()},
+		{"None", None[string](), None[string]()},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := short(nonEmpty(test.o))
+
+			if !Equals(v, test.expected) {
+				t.Errorf("expected composed filters to return %v, got: %v", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		t.Run("Keep", func(t *testing.T) {
+			o := Some("hello")
+
+			v, err := Validate(o, func(v string) error { return nil })
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !Equals(v, o) {
+				t.Error("expected Validate to return Some(\"hello\"), got:", v)
+			}
+		})
+
+		t.Run("Reject", func(t *testing.T) {
+			o := Some("hello")
+
+			e := errors.New("error")
+
+			v, err := Validate(o, func(v string) error { return e })
+			if err != e {
+				t.Error("expected Validate to return error, got:", err)
+			}
+
+			if !IsNone(v) {
+				t.Error("expected Validate to return None, got:", v)
+			}
+		})
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[string]()
+
+		v, err := Validate(o, func(v string) error {
+			t.Error("expected Validate not to call the function on None")
+
+			return errors.New("error")
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !IsNone(v) {
+			t.Error("expected Validate to return None, got:", v)
+		}
+	})
+}
+
+func TestForEach(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		var calls []string
+
+		ForEach(Some("hello"), func(v string) { calls = append(calls, v) })
+
+		if len(calls) != 1 || calls[0] != "hello" {
+			t.Error("expected ForEach to call the function exactly once with the contained value, got:", calls)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		var calls int
+
+		ForEach(None[string](), func(v string) { calls++ })
+
+		if calls != 0 {
+			t.Error("expected ForEach not to call the function, got calls:", calls)
+		}
+	})
+}
+
+func TestIfSome(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		var calls []string
+
+		IfSome(Some("hello"), func(v string) { calls = append(calls, v) })
+
+		if len(calls) != 1 || calls[0] != "hello" {
+			t.Error("expected IfSome to call the function exactly once with the contained value, got:", calls)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		var calls int
+
+		IfSome(None[string](), func(v string) { calls++ })
+
+		if calls != 0 {
+			t.Error("expected IfSome not to call the function, got calls:", calls)
+		}
+	})
+}
+
+func TestIfNone(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		var calls int
+
+		IfNone(Some("hello"), func() { calls++ })
+
+		if calls != 0 {
+			t.Error("expected IfNone not to call the function, got calls:", calls)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		var calls int
+
+		IfNone(None[string](), func() { calls++ })
+
+		if calls != 1 {
+			t.Error("expected IfNone to call the function exactly once, got calls:", calls)
+		}
+	})
+}
+
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) Printf(format string, v ...any) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestInspectLog(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		var logger testLogger
+
+		o := Some(42)
+
+		v := InspectLog(o, &logger, "answer: %v")
+
+		if !Equals(v, o) {
+			t.Error("expected InspectLog to return o, got:", v)
+		}
+
+		if len(logger.lines) != 1 || logger.lines[0] != "answer: 42" {
+			t.Error("expected InspectLog to log the contained value, got:", logger.lines)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		var logger testLogger
+
+		v := InspectLog(None[int](), &logger, "answer: %v")
+
+		if !IsNone(v) {
+			t.Error("expected InspectLog to return None, got:", v)
+		}
+
+		if len(logger.lines) != 1 || logger.lines[0] != "answer: none" {
+			t.Error("expected InspectLog to log the none marker, got:", logger.lines)
+		}
+	})
+}
+
+func TestContainsBy(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		t.Run("true", func(t *testing.T) {
+			o := Some("hello")
+
+			if !ContainsBy(o, func(v string) bool { return v == "hello" }) {
+				t.Error("expected ContainsBy to return true")
+			}
+		})
+
+		t.Run("false", func(t *testing.T) {
+			o := Some("hello")
+
+			if ContainsBy(o, func(v string) bool { return v == "world" }) {
+				t.Error("expected ContainsBy to return false")
+			}
+		})
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[string]()
+
+		if ContainsBy(o, func(v string) bool { return true }) {
+			t.Error("expected ContainsBy to return false")
+		}
+	})
+}
+
+func TestSatisfies(t *testing.T) {
+	notEmpty := func(v string) bool { return v != "" }
+	short := func(v string) bool { return len(v) < 10 }
+
+	t.Run("Some", func(t *testing.T) {
+		t.Run("Pass", func(t *testing.T) {
+			if !Satisfies(Some("hello"), notEmpty, short) {
+				t.Error("expected Satisfies to return true when every predicate passes")
+			}
+		})
+
+		t.Run("Fail", func(t *testing.T) {
+			if Satisfies(Some("hello world"), notEmpty, short) {
+				t.Error("expected Satisfies to return false when a predicate fails")
+			}
+		})
+
+		t.Run("NoPredicates", func(t *testing.T) {
+			if !Satisfies(Some("hello")) {
+				t.Error("expected Satisfies to return true without predicates")
+			}
+		})
+	})
+
+	t.Run("None", func(t *testing.T) {
+		if !Satisfies(None[string](), notEmpty, func(string) bool { return false }) {
+			t.Error("expected Satisfies to return true for None")
+		}
+	})
+}
+
 func TestEquals(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		t.Run("True", func(t *testing.T) {
@@ -708,3 +1654,112 @@ func TestEquals(t *testing.T) {
 		}
 	})
 }
+
+func TestWeakEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		o1       Option[int]
+		o2       Option[int]
+		expected bool
+	}{
+		{"SomeZeroAndNone", Some(0), None[int](), true},
+		{"NoneAndSomeZero", None[int](), Some(0), true},
+		{"SomeAndNone", Some(5), None[int](), false},
+		{"EqualSomes", Some(5), Some(5), true},
+		{"DifferentSomes", Some(5), Some(6), false},
+		{"Nones", None[int](), None[int](), true},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if v := WeakEquals(test.o1, test.o2); v != test.expected {
+				t.Errorf("expected WeakEquals to return %t, got: %t", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestAllEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		os       []Option[string]
+		expected bool
+	}{
+		{"Empty", nil, true},
+		{"Single", []Option[string]{Some("hello")}, true},
+		{"AllSomeEqual", []Option[string]{Some("hello"), Some("hello"), Some("hello")}, true},
+		{"AllNone", []Option[string]{None[string](), None[string](), None[string]()}, true},
+		{"OneDifferent", []Option[string]{Some("hello"), Some("hello"), Some("world")}, false},
+		{"MixedPresence", []Option[string]{Some("hello"), None[string](), Some("hello")}, false},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if v := AllEqual(test.os...); v != test.expected {
+				t.Errorf("expected AllEqual to return %t, got: %t", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestEqualsEq(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		t.Run("True", func(t *testing.T) {
+			now := time.Now()
+
+			o1 := Some(now)
+			o2 := Some(now.In(time.FixedZone("test", 3600)))
+
+			if !EqualsEq(o1, o2) {
+				t.Error("two Somes holding equal values are expected to be equal")
+			}
+		})
+
+		t.Run("False", func(t *testing.T) {
+			now := time.Now()
+
+			o1 := Some(now)
+			o2 := Some(now.Add(time.Second))
+
+			if EqualsEq(o1, o2) {
+				t.Error("two Somes holding different values are not expected to be equal")
+			}
+		})
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o1 := None[time.Time]()
+		o2 := None[time.Time]()
+
+		if !EqualsEq(o1, o2) {
+			t.Error("two Nones are expected to be equal")
+		}
+	})
+
+	t.Run("SomeAndNone", func(t *testing.T) {
+		o1 := Some(time.Now())
+		o2 := None[time.Time]()
+
+		if EqualsEq(o1, o2) {
+			t.Error("a Some and a None should never be equal")
+		}
+	})
+}
+
+func equalSlices[T comparable](s1 []Option[T], s2 []Option[T]) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+
+	for i := range s1 {
+		if !Equals(s1[i], s2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
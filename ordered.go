@@ -0,0 +1,61 @@
+//go:build go1.21
+
+package option
+
+import "cmp"
+
+// InRange returns a Some holding v if it is within the inclusive range [lo, hi], otherwise returns a None.
+func InRange[T cmp.Ordered](v T, lo T, hi T) Option[T] {
+	if cmp.Less(v, lo) || cmp.Less(hi, v) {
+		return None[T]()
+	}
+
+	return Some(v)
+}
+
+// Clamp clamps the contained value (if any) into the inclusive range [lo, hi] or returns a None.
+//
+// Unlike InRange (which rejects values outside of the range), Clamp coerces them into the range.
+func Clamp[T cmp.Ordered](o Option[T], lo T, hi T) Option[T] {
+	if IsNone(o) {
+		return None[T]()
+	}
+
+	return Some(min(max(o.Value(), lo), hi))
+}
+
+// Ordered is an optional value with an ordering method,
+// so it can be used in generic algorithms and data structures (eg. heaps and trees) that require one.
+//
+// Ordered values are ordered according to Compare (Nones sort first).
+//
+// Ordered implements Option, so it can be used with the rest of the package.
+// The zero value is a None.
+type Ordered[T cmp.Ordered] struct {
+	value T
+	valid bool
+}
+
+// NewOrdered returns a new Ordered holding the same state as o.
+func NewOrdered[T cmp.Ordered](o Option[T]) Ordered[T] {
+	return Ordered[T]{
+		value: o.Value(),
+		valid: o.HasValue(),
+	}
+}
+
+// HasValue implements Option.
+func (o Ordered[T]) HasValue() bool {
+	return o.valid
+}
+
+// Value implements Option.
+func (o Ordered[T]) Value() T {
+	return o.value
+}
+
+// Cmp compares o to other according to Compare.
+// The result is -1 if o is less than other, 0 if they are equal and +1 if o is greater than other.
+func (o Ordered[T]) Cmp(other Ordered[T]) int {
+	return Compare[T](o, other)
+}
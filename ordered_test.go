@@ -0,0 +1,130 @@
+//go:build go1.21
+
+package option
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+	"testing"
+)
+
+func TestInRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        int
+		expected Option[int]
+	}{
+		{"Below", 0, None[int]()},
+		{"Low", 1, Some(1)},
+		{"Within", 5, Some(5)},
+		{"High", 10, Some(10)},
+		{"Above", 11, None[int]()},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := InRange(test.v, 1, 10)
+
+			if !Equals(v, test.expected) {
+				t.Errorf("expected InRange to return %v, got: %v", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name     string
+		o        Option[int]
+		expected Option[int]
+	}{
+		{"Below", Some(0), Some(1)},
+		{"Within", Some(5), Some(5)},
+		{"Above", Some(11), Some(10)},
+		{"None", None[int](), None[int]()},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := Clamp(test.o, 1, 10)
+
+			if !Equals(v, test.expected) {
+				t.Errorf("expected Clamp to return %v, got: %v", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestOrdered(t *testing.T) {
+	tests := []struct {
+		o1       Ordered[int]
+		o2       Ordered[int]
+		expected int
+	}{
+		{Ordered[int]{}, NewOrdered(None[int]()), 0},
+		{NewOrdered(None[int]()), NewOrdered(Some(1)), -1},
+		{NewOrdered(Some(1)), NewOrdered(None[int]()), 1},
+		{NewOrdered(Some(1)), NewOrdered(Some(2)), -1},
+		{NewOrdered(Some(1)), NewOrdered(Some(1)), 0},
+	}
+
+	for _, test := range tests {
+		if v := test.o1.Cmp(test.o2); v != test.expected {
+			t.Errorf("expected %v.Cmp(%v) to return %d, got: %d", test.o1, test.o2, test.expected, v)
+		}
+	}
+
+	t.Run("SortFunc", func(t *testing.T) {
+		os := []Ordered[int]{NewOrdered(Some(2)), NewOrdered(None[int]()), NewOrdered(Some(1))}
+
+		slices.SortFunc(os, Ordered[int].Cmp)
+
+		expected := []Ordered[int]{NewOrdered(None[int]()), NewOrdered(Some(1)), NewOrdered(Some(2))}
+
+		if !slices.Equal(os, expected) {
+			t.Error("expected Ordered values to be sorted with Nones first, got:", os)
+		}
+	})
+
+	t.Run("Heap", func(t *testing.T) {
+		h := &orderedHeap[int]{NewOrdered(Some(3)), NewOrdered(Some(1)), NewOrdered(None[int]()), NewOrdered(Some(2))}
+
+		heap.Init(h)
+
+		var popped []Ordered[int]
+
+		for h.Len() > 0 {
+			popped = append(popped, heap.Pop(h).(Ordered[int]))
+		}
+
+		expected := []Ordered[int]{NewOrdered(None[int]()), NewOrdered(Some(1)), NewOrdered(Some(2)), NewOrdered(Some(3))}
+
+		if !slices.Equal(popped, expected) {
+			t.Error("expected Ordered values to be popped with Nones first, got:", popped)
+		}
+	})
+}
+
+type orderedHeap[T cmp.Ordered] []Ordered[T]
+
+func (h orderedHeap[T]) Len() int           { return len(h) }
+func (h orderedHeap[T]) Less(i, j int) bool { return h[i].Cmp(h[j]) < 0 }
+func (h orderedHeap[T]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *orderedHeap[T]) Push(x any) {
+	*h = append(*h, x.(Ordered[T]))
+}
+
+func (h *orderedHeap[T]) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+
+	return x
+}
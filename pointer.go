@@ -0,0 +1,99 @@
+package option
+
+// Update applies the provided function to the value contained in the Option pointed to by o (if any) and stores the result in its place.
+// It does nothing if *o does not contain a value.
+func Update[T any](o *Option[T], f func(v T) T) {
+	if IsNone(*o) {
+		return
+	}
+
+	*o = Some(f((*o).Value()))
+}
+
+// Swap exchanges the Options pointed to by a and b (including their presence).
+func Swap[T any](a *Option[T], b *Option[T]) {
+	*a, *b = *b, *a
+}
+
+// Replace overwrites the Option pointed to by dst with src and returns the previous Option.
+func Replace[T any](dst *Option[T], src Option[T]) Option[T] {
+	old := *dst
+	*dst = src
+
+	return old
+}
+
+// Take returns the Option pointed to by o and leaves a None in its place.
+func Take[T any](o *Option[T]) Option[T] {
+	return Replace(o, None[T]())
+}
+
+// TakeIf returns the Option pointed to by o and leaves a None in its place
+// if it contains a value and the provided predicate applied to the contained value returns true.
+// Otherwise it leaves *o untouched and returns a None.
+func TakeIf[T any](o *Option[T], pred func(v T) bool) Option[T] {
+	if IsNone(*o) || !pred((*o).Value()) {
+		return None[T]()
+	}
+
+	return Take(o)
+}
+
+// Slot is an optional value stored inline (instead of behind an interface),
+// so it can be overwritten in place without allocating (see MapIntoSlot).
+//
+// Slot has value semantics: copying a Slot copies its value, so copies are not affected by later writes.
+//
+// Slot implements Option, so it can be used with the rest of the package.
+// The zero value is a None.
+type Slot[T any] struct {
+	value T
+	valid bool
+}
+
+// NewSlot returns a new Slot holding the same state as o.
+func NewSlot[T any](o Option[T]) Slot[T] {
+	return Slot[T]{
+		value: o.Value(),
+		valid: o.HasValue(),
+	}
+}
+
+// HasValue implements Option.
+func (s Slot[T]) HasValue() bool {
+	return s.valid
+}
+
+// Value implements Option.
+func (s Slot[T]) Value() T {
+	return s.value
+}
+
+// Ptr returns a Some holding a pointer to the value stored in s (if any) or returns a None.
+//
+// Reads and writes through the returned pointer access the storage of s directly (without copying the value).
+// Copies of s are not affected (they have their own storage).
+func (s *Slot[T]) Ptr() Option[*T] {
+	if !s.valid {
+		return None[*T]()
+	}
+
+	return Some(&s.value)
+}
+
+// MapIntoSlot applies the provided function to the contained value (if any) and stores the result in the Slot pointed to by dst,
+// otherwise stores a None.
+//
+// Unlike Map (which allocates a new Option for every result), MapIntoSlot overwrites dst in place, so it does not allocate.
+func MapIntoSlot[T any, U any](o Option[T], dst *Slot[U], f func(v T) U) {
+	if IsNone(o) {
+		*dst = Slot[U]{}
+
+		return
+	}
+
+	*dst = Slot[U]{
+		value: f(o.Value()),
+		valid: true,
+	}
+}
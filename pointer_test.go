@@ -0,0 +1,269 @@
+package option
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := Some("hello")
+
+		Update(&o, strings.ToUpper)
+
+		if !Equals(o, Some("HELLO")) {
+			t.Error("expected Update to update the contained value, got:", o)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[string]()
+
+		Update(&o, func(v string) string {
+			t.Error("expected Update not to call the function on None")
+
+			return v
+		})
+
+		if !IsNone(o) {
+			t.Error("expected Update to leave None unchanged, got:", o)
+		}
+	})
+}
+
+func TestSwap(t *testing.T) {
+	t.Run("SomeAndNone", func(t *testing.T) {
+		a := Some("hello")
+		b := None[string]()
+
+		Swap(&a, &b)
+
+		if !IsNone(a) || !Equals(b, Some("hello")) {
+			t.Errorf("expected Swap to exchange the Options, got: %v, %v", a, b)
+		}
+	})
+
+	t.Run("SomeAndSome", func(t *testing.T) {
+		a := Some("hello")
+		b := Some("world")
+
+		Swap(&a, &b)
+
+		if !Equals(a, Some("world")) || !Equals(b, Some("hello")) {
+			t.Errorf("expected Swap to exchange the Options, got: %v, %v", a, b)
+		}
+	})
+
+	t.Run("NoneAndNone", func(t *testing.T) {
+		a := None[string]()
+		b := None[string]()
+
+		Swap(&a, &b)
+
+		if !IsNone(a) || !IsNone(b) {
+			t.Errorf("expected Swap to leave both Options None, got: %v, %v", a, b)
+		}
+	})
+}
+
+func TestReplace(t *testing.T) {
+	o := Some("hello")
+
+	old := Replace(&o, None[string]())
+
+	if !IsNone(o) {
+		t.Error("expected Replace to overwrite the Option, got:", o)
+	}
+
+	if !Equals(old, Some("hello")) {
+		t.Error("expected Replace to return the previous Option, got:", old)
+	}
+}
+
+func TestTake(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := Some("hello")
+
+		v := Take(&o)
+
+		if !Equals(v, Some("hello")) {
+			t.Error("expected Take to return Some(\"hello\"), got:", v)
+		}
+
+		if !IsNone(o) {
+			t.Error("expected Take to leave a None behind, got:", o)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[string]()
+
+		v := Take(&o)
+
+		if !IsNone(v) || !IsNone(o) {
+			t.Error("expected Take to return None, got:", v)
+		}
+	})
+}
+
+func TestTakeIf(t *testing.T) {
+	isHello := func(v string) bool { return v == "hello" }
+
+	t.Run("True", func(t *testing.T) {
+		o := Some("hello")
+
+		v := TakeIf(&o, isHello)
+
+		if !Equals(v, Some("hello")) {
+			t.Error("expected TakeIf to return Some(\"hello\"), got:", v)
+		}
+
+		if !IsNone(o) {
+			t.Error("expected TakeIf to leave a None behind, got:", o)
+		}
+	})
+
+	t.Run("False", func(t *testing.T) {
+		o := Some("world")
+
+		v := TakeIf(&o, isHello)
+
+		if !IsNone(v) {
+			t.Error("expected TakeIf to return None, got:", v)
+		}
+
+		if !Equals(o, Some("world")) {
+			t.Error("expected TakeIf to leave the Option untouched, got:", o)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[string]()
+
+		v := TakeIf(&o, func(v string) bool {
+			t.Error("expected TakeIf not to call the predicate")
+
+			return true
+		})
+
+		if !IsNone(v) || !IsNone(o) {
+			t.Error("expected TakeIf to return None, got:", v)
+		}
+	})
+}
+
+func TestMapIntoSlot(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		var dst Slot[string]
+
+		MapIntoSlot(Some("hello"), &dst, strings.ToUpper)
+
+		if !Equals[string](dst, Some("HELLO")) {
+			t.Error("expected MapIntoSlot to store Some(\"HELLO\"), got:", dst)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		dst := NewSlot(Some("hello"))
+
+		MapIntoSlot(None[string](), &dst, strings.ToUpper)
+
+		if !IsNone[string](dst) {
+			t.Error("expected MapIntoSlot to store None, got:", dst)
+		}
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		var (
+			dst Slot[int]
+			out []Slot[int]
+		)
+
+		for i := 1; i <= 3; i++ {
+			MapIntoSlot(Some(i), &dst, func(v int) int { return v * 10 })
+
+			out = append(out, dst)
+		}
+
+		for i, s := range out {
+			if !Equals[int](s, Some((i+1)*10)) {
+				t.Error("expected copies of dst not to change after a later MapIntoSlot, got:", out)
+			}
+		}
+	})
+
+	t.Run("NoAllocation", func(t *testing.T) {
+		o := Some(1000)
+
+		var dst Slot[string]
+
+		MapIntoSlot(o, &dst, strconv.Itoa)
+
+		allocs := testing.AllocsPerRun(100, func() {
+			MapIntoSlot(o, &dst, func(v int) string { return "hello" })
+		})
+
+		if allocs != 0 {
+			t.Error("expected MapIntoSlot not to allocate, got allocations:", allocs)
+		}
+	})
+}
+
+func TestSlot_Ptr(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		s := NewSlot(Some([]string{"hello"}))
+
+		p := s.Ptr()
+
+		if !IsSome(p) {
+			t.Fatal("expected Ptr to return Some, got:", p)
+		}
+
+		c := s
+
+		*p.Value() = []string{"world"}
+
+		if v := s.Value(); len(v) != 1 || v[0] != "world" {
+			t.Error("expected mutation through the pointer to reflect in the Slot, got:", v)
+		}
+
+		if v := c.Value(); len(v) != 1 || v[0] != "hello" {
+			t.Error("expected a copy of the Slot not to be affected, got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		var s Slot[string]
+
+		if p := s.Ptr(); !IsNone(p) {
+			t.Error("expected Ptr to return None, got:", p)
+		}
+	})
+}
+
+func BenchmarkMap(b *testing.B) {
+	b.ReportAllocs()
+
+	o := Some(1000)
+
+	var dst Option[string]
+
+	for i := 0; i < b.N; i++ {
+		dst = Map(o, func(v int) string { return "hello" })
+	}
+
+	_ = dst
+}
+
+func BenchmarkMapIntoSlot(b *testing.B) {
+	b.ReportAllocs()
+
+	o := Some(1000)
+
+	var dst Slot[string]
+
+	for i := 0; i < b.N; i++ {
+		MapIntoSlot(o, &dst, func(v int) string { return "hello" })
+	}
+}
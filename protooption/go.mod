@@ -0,0 +1,10 @@
+module github.com/sagikazarmark/go-option/protooption
+
+go 1.26
+
+require (
+	github.com/sagikazarmark/go-option v0.0.0-20261014104735-5ba0a0fb6eeb
+	google.golang.org/protobuf v1.36.12
+)
+
+replace github.com/sagikazarmark/go-option => ../
@@ -0,0 +1,174 @@
+// Package protooption converts between optional values and the protobuf well-known wrapper types.
+//
+// A nil wrapper is converted to a None and a None is converted to a nil wrapper.
+//
+// It lives in its own module so that protobuf does not become a dependency of the option package.
+package protooption
+
+import (
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+// FromDoubleValue converts a [wrapperspb.DoubleValue] to an Option.
+func FromDoubleValue(v *wrapperspb.DoubleValue) option.Option[float64] {
+	if v == nil {
+		return option.None[float64]()
+	}
+
+	return option.Some(v.GetValue())
+}
+
+// ToDoubleValue converts an Option to a [wrapperspb.DoubleValue].
+func ToDoubleValue(o option.Option[float64]) *wrapperspb.DoubleValue {
+	if option.IsNone(o) {
+		return nil
+	}
+
+	return wrapperspb.Double(o.Value())
+}
+
+// FromFloatValue converts a [wrapperspb.FloatValue] to an Option.
+func FromFloatValue(v *wrapperspb.FloatValue) option.Option[float32] {
+	if v == nil {
+		return option.None[float32]()
+	}
+
+	return option.Some(v.GetValue())
+}
+
+// ToFloatValue converts an Option to a [wrapperspb.FloatValue].
+func ToFloatValue(o option.Option[float32]) *wrapperspb.FloatValue {
+	if option.IsNone(o) {
+		return nil
+	}
+
+	return wrapperspb.Float(o.Value())
+}
+
+// FromInt64Value converts a [wrapperspb.Int64Value] to an Option.
+func FromInt64Value(v *wrapperspb.Int64Value) option.Option[int64] {
+	if v == nil {
+		return option.None[int64]()
+	}
+
+	return option.Some(v.GetValue())
+}
+
+// ToInt64Value converts an Option to a [wrapperspb.Int64Value].
+func ToInt64Value(o option.Option[int64]) *wrapperspb.Int64Value {
+	if option.IsNone(o) {
+		return nil
+	}
+
+	return wrapperspb.Int64(o.Value())
+}
+
+// FromUInt64Value converts a [wrapperspb.UInt64Value] to an Option.
+func FromUInt64Value(v *wrapperspb.UInt64Value) option.Option[uint64] {
+	if v == nil {
+		return option.None[uint64]()
+	}
+
+	return option.Some(v.GetValue())
+}
+
+// ToUInt64Value converts an Option to a [wrapperspb.UInt64Value].
+func ToUInt64Value(o option.Option[uint64]) *wrapperspb.UInt64Value {
+	if option.IsNone(o) {
+		return nil
+	}
+
+	return wrapperspb.UInt64(o.Value())
+}
+
+// FromInt32Value converts a [wrapperspb.Int32Value] to an Option.
+func FromInt32Value(v *wrapperspb.Int32Value) option.Option[int32] {
+	if v == nil {
+		return option.None[int32]()
+	}
+
+	return option.Some(v.GetValue())
+}
+
+// ToInt32Value converts an Option to a [wrapperspb.Int32Value].
+func ToInt32Value(o option.Option[int32]) *wrapperspb.Int32Value {
+	if option.IsNone(o) {
+		return nil
+	}
+
+	return wrapperspb.Int32(o.Value())
+}
+
+// FromUInt32Value converts a [wrapperspb.UInt32Value] to an Option.
+func FromUInt32Value(v *wrapperspb.UInt32Value) option.Option[uint32] {
+	if v == nil {
+		return option.None[uint32]()
+	}
+
+	return option.Some(v.GetValue())
+}
+
+// ToUInt32Value converts an Option to a [wrapperspb.UInt32Value].
+func ToUInt32Value(o option.Option[uint32]) *wrapperspb.UInt32Value {
+	if option.IsNone(o) {
+		return nil
+	}
+
+	return wrapperspb.UInt32(o.Value())
+}
+
+// FromBoolValue converts a [wrapperspb.BoolValue] to an Option.
+func FromBoolValue(v *wrapperspb.BoolValue) option.Option[bool] {
+	if v == nil {
+		return option.None[bool]()
+	}
+
+	return option.Some(v.GetValue())
+}
+
+// ToBoolValue converts an Option to a [wrapperspb.BoolValue].
+func ToBoolValue(o option.Option[bool]) *wrapperspb.BoolValue {
+	if option.IsNone(o) {
+		return nil
+	}
+
+	return wrapperspb.Bool(o.Value())
+}
+
+// FromStringValue converts a [wrapperspb.StringValue] to an Option.
+func FromStringValue(v *wrapperspb.StringValue) option.Option[string] {
+	if v == nil {
+		return option.None[string]()
+	}
+
+	return option.Some(v.GetValue())
+}
+
+// ToStringValue converts an Option to a [wrapperspb.StringValue].
+func ToStringValue(o option.Option[string]) *wrapperspb.StringValue {
+	if option.IsNone(o) {
+		return nil
+	}
+
+	return wrapperspb.String(o.Value())
+}
+
+// FromBytesValue converts a [wrapperspb.BytesValue] to an Option.
+func FromBytesValue(v *wrapperspb.BytesValue) option.Option[[]byte] {
+	if v == nil {
+		return option.None[[]byte]()
+	}
+
+	return option.Some(v.GetValue())
+}
+
+// ToBytesValue converts an Option to a [wrapperspb.BytesValue].
+func ToBytesValue(o option.Option[[]byte]) *wrapperspb.BytesValue {
+	if option.IsNone(o) {
+		return nil
+	}
+
+	return wrapperspb.Bytes(o.Value())
+}
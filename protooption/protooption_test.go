@@ -0,0 +1,280 @@
+package protooption
+
+import (
+	"bytes"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+func TestDoubleValue(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := FromDoubleValue(wrapperspb.Double(1.5))
+
+		if !option.Equals(o, option.Some[float64](1.5)) {
+			t.Error("expected FromDoubleValue to return Some(1.5), got:", o)
+		}
+
+		w := ToDoubleValue(option.Some[float64](1.5))
+
+		if w == nil || w.GetValue() != 1.5 {
+			t.Error("expected ToDoubleValue to return a wrapper holding 1.5, got:", w)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := FromDoubleValue(nil)
+
+		if !option.IsNone(o) {
+			t.Error("expected FromDoubleValue to return None, got:", o)
+		}
+
+		w := ToDoubleValue(option.None[float64]())
+
+		if w != nil {
+			t.Error("expected ToDoubleValue to return nil, got:", w)
+		}
+	})
+}
+
+func TestFloatValue(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := FromFloatValue(wrapperspb.Float(1.5))
+
+		if !option.Equals(o, option.Some[float32](1.5)) {
+			t.Error("expected FromFloatValue to return Some(1.5), got:", o)
+		}
+
+		w := ToFloatValue(option.Some[float32](1.5))
+
+		if w == nil || w.GetValue() != 1.5 {
+			t.Error("expected ToFloatValue to return a wrapper holding 1.5, got:", w)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := FromFloatValue(nil)
+
+		if !option.IsNone(o) {
+			t.Error("expected FromFloatValue to return None, got:", o)
+		}
+
+		w := ToFloatValue(option.None[float32]())
+
+		if w != nil {
+			t.Error("expected ToFloatValue to return nil, got:", w)
+		}
+	})
+}
+
+func TestInt64Value(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := FromInt64Value(wrapperspb.Int64(42))
+
+		if !option.Equals(o, option.Some[int64](42)) {
+			t.Error("expected FromInt64Value to return Some(42), got:", o)
+		}
+
+		w := ToInt64Value(option.Some[int64](42))
+
+		if w == nil || w.GetValue() != 42 {
+			t.Error("expected ToInt64Value to return a wrapper holding 42, got:", w)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := FromInt64Value(nil)
+
+		if !option.IsNone(o) {
+			t.Error("expected FromInt64Value to return None, got:", o)
+		}
+
+		w := ToInt64Value(option.None[int64]())
+
+		if w != nil {
+			t.Error("expected ToInt64Value to return nil, got:", w)
+		}
+	})
+}
+
+func TestUInt64Value(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := FromUInt64Value(wrapperspb.UInt64(42))
+
+		if !option.Equals(o, option.Some[uint64](42)) {
+			t.Error("expected FromUInt64Value to return Some(42), got:", o)
+		}
+
+		w := ToUInt64Value(option.Some[uint64](42))
+
+		if w == nil || w.GetValue() != 42 {
+			t.Error("expected ToUInt64Value to return a wrapper holding 42, got:", w)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := FromUInt64Value(nil)
+
+		if !option.IsNone(o) {
+			t.Error("expected FromUInt64Value to return None, got:", o)
+		}
+
+		w := ToUInt64Value(option.None[uint64]())
+
+		if w != nil {
+			t.Error("expected ToUInt64Value to return nil, got:", w)
+		}
+	})
+}
+
+func TestInt32Value(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := FromInt32Value(wrapperspb.Int32(42))
+
+		if !option.Equals(o, option.Some[int32](42)) {
+			t.Error("expected FromInt32Value to return Some(42), got:", o)
+		}
+
+		w := ToInt32Value(option.Some[int32](42))
+
+		if w == nil || w.GetValue() != 42 {
+			t.Error("expected ToInt32Value to return a wrapper holding 42, got:", w)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := FromInt32Value(nil)
+
+		if !option.IsNone(o) {
+			t.Error("expected FromInt32Value to return None, got:", o)
+		}
+
+		w := ToInt32Value(option.None[int32]())
+
+		if w != nil {
+			t.Error("expected ToInt32Value to return nil, got:", w)
+		}
+	})
+}
+
+func TestUInt32Value(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := FromUInt32Value(wrapperspb.UInt32(42))
+
+		if !option.Equals(o, option.Some[uint32](42)) {
+			t.Error("expected FromUInt32Value to return Some(42), got:", o)
+		}
+
+		w := ToUInt32Value(option.Some[uint32](42))
+
+		if w == nil || w.GetValue() != 42 {
+			t.Error("expected ToUInt32Value to return a wrapper holding 42, got:", w)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := FromUInt32Value(nil)
+
+		if !option.IsNone(o) {
+			t.Error("expected FromUInt32Value to return None, got:", o)
+		}
+
+		w := ToUInt32Value(option.None[uint32]())
+
+		if w != nil {
+			t.Error("expected ToUInt32Value to return nil, got:", w)
+		}
+	})
+}
+
+func TestBoolValue(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := FromBoolValue(wrapperspb.Bool(true))
+
+		if !option.Equals(o, option.Some[bool](true)) {
+			t.Error("expected FromBoolValue to return Some(true), got:", o)
+		}
+
+		w := ToBoolValue(option.Some[bool](true))
+
+		if w == nil || w.GetValue() != true {
+			t.Error("expected ToBoolValue to return a wrapper holding true, got:", w)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := FromBoolValue(nil)
+
+		if !option.IsNone(o) {
+			t.Error("expected FromBoolValue to return None, got:", o)
+		}
+
+		w := ToBoolValue(option.None[bool]())
+
+		if w != nil {
+			t.Error("expected ToBoolValue to return nil, got:", w)
+		}
+	})
+}
+
+func TestStringValue(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := FromStringValue(wrapperspb.String("hello"))
+
+		if !option.Equals(o, option.Some("hello")) {
+			t.Error("expected FromStringValue to return Some(\"hello\"), got:", o)
+		}
+
+		w := ToStringValue(option.Some("hello"))
+
+		if w == nil || w.GetValue() != "hello" {
+			t.Error("expected ToStringValue to return a wrapper holding \"hello\", got:", w)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := FromStringValue(nil)
+
+		if !option.IsNone(o) {
+			t.Error("expected FromStringValue to return None, got:", o)
+		}
+
+		w := ToStringValue(option.None[string]())
+
+		if w != nil {
+			t.Error("expected ToStringValue to return nil, got:", w)
+		}
+	})
+}
+
+func TestBytesValue(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		o := FromBytesValue(wrapperspb.Bytes([]byte("hello")))
+
+		if !option.IsSome(o) || !bytes.Equal(o.Value(), []byte("hello")) {
+			t.Error("expected FromBytesValue to return Some(\"hello\"), got:", o)
+		}
+
+		w := ToBytesValue(option.Some([]byte("hello")))
+
+		if w == nil || !bytes.Equal(w.GetValue(), []byte("hello")) {
+			t.Error("expected ToBytesValue to return a wrapper holding \"hello\", got:", w)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := FromBytesValue(nil)
+
+		if !option.IsNone(o) {
+			t.Error("expected FromBytesValue to return None, got:", o)
+		}
+
+		w := ToBytesValue(option.None[[]byte]())
+
+		if w != nil {
+			t.Error("expected ToBytesValue to return nil, got:", w)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package reflectoption
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+// Change describes how an optional struct field changed between two states.
+type Change struct {
+	// Transition classifies the change.
+	Transition option.Transition
+
+	// Old is the contained value of the old state (or nil if it had none).
+	Old any
+
+	// New is the contained value of the new state (or nil if it has none).
+	New any
+}
+
+// DiffFields compares the optional fields of two structs (or pointers to structs) and reports the ones that changed, keyed by field name.
+//
+// Only exported fields of a type implementing [option.Option] are compared, every other field is ignored.
+// A nil option.Option field is treated as a None.
+// Contained values are compared using [reflect.DeepEqual].
+//
+// DiffFields panics if T is not a struct or a pointer to a struct, or if either pointer is nil.
+func DiffFields[T any](old T, updated T) map[string]Change {
+	ov := structValue(old)
+	nv := structValue(updated)
+
+	changes := make(map[string]Change)
+
+	for i := 0; i < ov.NumField(); i++ {
+		sf := ov.Type().Field(i)
+		if !sf.IsExported() || !isOptionType(sf.Type) {
+			continue
+		}
+
+		oldValue, oldValid := optionState(ov.Field(i))
+		newValue, newValid := optionState(nv.Field(i))
+
+		var transition option.Transition
+
+		switch {
+		case !oldValid && newValid:
+			transition = option.AddedSome
+
+		case oldValid && !newValid:
+			transition = option.RemovedSome
+
+		case oldValid && newValid && !reflect.DeepEqual(oldValue, newValue):
+			transition = option.ChangedValue
+
+		default:
+			continue
+		}
+
+		changes[sf.Name] = Change{
+			Transition: transition,
+			Old:        oldValue,
+			New:        newValue,
+		}
+	}
+
+	return changes
+}
+
+func structValue(s any) reflect.Value {
+	v := reflect.ValueOf(s)
+
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("reflectoption: expected a struct or a non-nil pointer to a struct, got %T", s))
+	}
+
+	return v
+}
+
+// isOptionType reports whether t implements option.Option for some type parameter.
+func isOptionType(t reflect.Type) bool {
+	hasValue, ok := t.MethodByName("HasValue")
+	if !ok || !isMethod(t, hasValue.Type, 0, 1) || hasValue.Type.Out(0).Kind() != reflect.Bool {
+		return false
+	}
+
+	value, ok := t.MethodByName("Value")
+
+	return ok && isMethod(t, value.Type, 0, 1)
+}
+
+// isMethod checks the arity of a method type.
+// Method types obtained from concrete types include the receiver, while the ones obtained from interfaces do not.
+func isMethod(t reflect.Type, m reflect.Type, in int, out int) bool {
+	if t.Kind() != reflect.Interface {
+		in++
+	}
+
+	return m.NumIn() == in && m.NumOut() == out
+}
+
+// optionState returns the contained value (if any) of an Option stored in v.
+func optionState(v reflect.Value) (any, bool) {
+	if (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) && v.IsNil() {
+		return nil, false
+	}
+
+	if !v.MethodByName("HasValue").Call(nil)[0].Bool() {
+		return nil, false
+	}
+
+	return v.MethodByName("Value").Call(nil)[0].Interface(), true
+}
@@ -0,0 +1,77 @@
+package reflectoption
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+type user struct {
+	ID       int
+	Name     option.Option[string]
+	Email    option.Option[string]
+	Age      option.Option[int]
+	Tags     option.Option[[]string]
+	Verified option.Field[bool]
+	Nickname option.Option[string]
+}
+
+func TestDiffFields(t *testing.T) {
+	old := user{
+		ID:       1,
+		Name:     option.Some("John"),
+		Email:    option.None[string](),
+		Age:      option.Some(30),
+		Tags:     option.Some([]string{"admin"}),
+		Verified: option.NewField(option.Some(true)),
+	}
+
+	updated := user{
+		ID:       2,
+		Name:     option.Some("Jane"),
+		Email:    option.Some("jane@example.com"),
+		Age:      option.None[int](),
+		Tags:     option.Some([]string{"admin"}),
+		Verified: option.NewField(option.Some(true)),
+		Nickname: option.None[string](),
+	}
+
+	expected := map[string]Change{
+		"Name":  {Transition: option.ChangedValue, Old: "John", New: "Jane"},
+		"Email": {Transition: option.AddedSome, Old: nil, New: "jane@example.com"},
+		"Age":   {Transition: option.RemovedSome, Old: 30, New: nil},
+	}
+
+	changes := DiffFields(old, updated)
+
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected DiffFields to return %v, got: %v", expected, changes)
+	}
+
+	t.Run("Pointer", func(t *testing.T) {
+		changes := DiffFields(&old, &updated)
+
+		if !reflect.DeepEqual(changes, expected) {
+			t.Errorf("expected DiffFields to return %v, got: %v", expected, changes)
+		}
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		changes := DiffFields(old, old)
+
+		if len(changes) != 0 {
+			t.Error("expected DiffFields to return no changes, got:", changes)
+		}
+	})
+
+	t.Run("NotAStruct", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected DiffFields to panic")
+			}
+		}()
+
+		DiffFields(1, 2)
+	})
+}
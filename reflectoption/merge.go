@@ -0,0 +1,47 @@
+package reflectoption
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MergeStructs returns a copy of base in which every optional field (a field of a type implementing [option.Option])
+// that contains a value in override is replaced by the field of override.
+// Optional fields that are None (or nil) in override keep the value of base.
+//
+// Nested structs are merged recursively; every other field keeps the value of base.
+// Only exported fields are merged.
+//
+// MergeStructs panics if T is not a struct.
+func MergeStructs[T any](base T, override T) T {
+	bv := reflect.ValueOf(base)
+	if bv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("reflectoption: expected a struct, got %T", base))
+	}
+
+	merged := reflect.New(bv.Type()).Elem()
+	merged.Set(bv)
+
+	mergeStruct(merged, reflect.ValueOf(override))
+
+	return merged.Interface().(T)
+}
+
+func mergeStruct(dst reflect.Value, override reflect.Value) {
+	for i := 0; i < dst.NumField(); i++ {
+		sf := dst.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		switch {
+		case isOptionType(sf.Type):
+			if _, ok := optionState(override.Field(i)); ok {
+				dst.Field(i).Set(override.Field(i))
+			}
+
+		case sf.Type.Kind() == reflect.Struct:
+			mergeStruct(dst.Field(i), override.Field(i))
+		}
+	}
+}
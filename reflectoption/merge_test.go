@@ -0,0 +1,84 @@
+package reflectoption
+
+import (
+	"testing"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+type serverConfig struct {
+	Host option.Option[string]
+	Port option.Option[int]
+}
+
+type appConfig struct {
+	Name    string
+	Debug   option.Option[bool]
+	Level   option.Field[string]
+	Timeout option.Option[int]
+	Server  serverConfig
+}
+
+func TestMergeStructs(t *testing.T) {
+	base := appConfig{
+		Name:    "base",
+		Debug:   option.Some(false),
+		Level:   option.NewField(option.Some("info")),
+		Timeout: option.Some(30),
+		Server: serverConfig{
+			Host: option.Some("localhost"),
+			Port: option.Some(8080),
+		},
+	}
+
+	override := appConfig{
+		Name:    "override",
+		Debug:   option.Some(true),
+		Level:   option.NewField(option.None[string]()),
+		Timeout: nil,
+		Server: serverConfig{
+			Host: option.None[string](),
+			Port: option.Some(9090),
+		},
+	}
+
+	merged := MergeStructs(base, override)
+
+	if merged.Name != "base" {
+		t.Error("expected a non-optional field to keep the base value, got:", merged.Name)
+	}
+
+	if !option.Equals(merged.Debug, option.Some(true)) {
+		t.Error("expected a Some to override the base value, got:", merged.Debug)
+	}
+
+	if !option.Equals[string](merged.Level, option.Some("info")) {
+		t.Error("expected a None to keep the base value, got:", merged.Level)
+	}
+
+	if !option.Equals(merged.Timeout, option.Some(30)) {
+		t.Error("expected a nil Option to keep the base value, got:", merged.Timeout)
+	}
+
+	if !option.Equals(merged.Server.Host, option.Some("localhost")) {
+		t.Error("expected a nested None to keep the base value, got:", merged.Server.Host)
+	}
+
+	if !option.Equals(merged.Server.Port, option.Some(9090)) {
+		t.Error("expected a nested Some to override the base value, got:", merged.Server.Port)
+	}
+
+	if !option.Equals(base.Debug, option.Some(false)) {
+		t.Error("expected MergeStructs not to modify base, got:", base.Debug)
+	}
+
+	t.Run("NotAStruct", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected MergeStructs to panic")
+			}
+		}()
+
+		MergeStructs(1, 2)
+	})
+}
@@ -0,0 +1,65 @@
+// Package reflectoption provides reflection based tools for working with optional values.
+//
+// It is meant to be used by generic code (eg. configuration loaders) where the structure of the data is only known at runtime.
+package reflectoption
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+// FieldOption returns the value of the named field of the struct pointed to by structPtr.
+//
+// If the field itself implements [option.Option] (eg. the field is an option.Option[T]), its state is returned as is.
+// Otherwise FieldOption returns a Some holding the value of the field if it is not the zero value of its type.
+//
+// FieldOption returns a None if the field does not exist, is unexported, cannot be assigned to T or holds the zero value of its type.
+// It panics if structPtr is not a non-nil pointer to a struct.
+func FieldOption[T any](structPtr any, fieldName string) option.Option[T] {
+	v := reflect.ValueOf(structPtr)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("reflectoption: expected a non-nil pointer to a struct, got %T", structPtr))
+	}
+
+	v = v.Elem()
+
+	sf, ok := v.Type().FieldByName(fieldName)
+	if !ok || !sf.IsExported() {
+		return option.None[T]()
+	}
+
+	// Traversing a nil embedded struct pointer fails: the field is not present
+	f, err := v.FieldByIndexErr(sf.Index)
+	if err != nil {
+		return option.None[T]()
+	}
+
+	if f.Type().Implements(optionType[T]()) {
+		if (f.Kind() == reflect.Interface || f.Kind() == reflect.Pointer) && f.IsNil() {
+			return option.None[T]()
+		}
+
+		o := f.Interface().(option.Option[T])
+		if option.IsNone(o) {
+			return option.None[T]()
+		}
+
+		return option.Some(o.Value())
+	}
+
+	if !f.Type().AssignableTo(typeOf[T]()) || f.IsZero() {
+		return option.None[T]()
+	}
+
+	return option.Some(f.Interface().(T))
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func optionType[T any]() reflect.Type {
+	return typeOf[option.Option[T]]()
+}
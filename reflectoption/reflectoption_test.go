@@ -0,0 +1,97 @@
+package reflectoption
+
+import (
+	"testing"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+type Embedded struct {
+	Region string
+}
+
+type config struct {
+	*Embedded
+
+	Name    string
+	Port    int
+	Timeout option.Option[int]
+	Debug   option.Field[bool]
+	Tags    []string
+
+	secret string
+}
+
+func TestFieldOption(t *testing.T) {
+	cfg := &config{
+		Name:    "app",
+		Timeout: option.Some(30),
+		Debug:   option.NewField(option.Some(true)),
+		secret:  "s3cr3t",
+	}
+
+	t.Run("Value", func(t *testing.T) {
+		v := FieldOption[string](cfg, "Name")
+
+		if !option.Equals(v, option.Some("app")) {
+			t.Error("expected FieldOption to return Some(\"app\"), got:", v)
+		}
+	})
+
+	t.Run("Option", func(t *testing.T) {
+		v := FieldOption[int](cfg, "Timeout")
+
+		if !option.Equals(v, option.Some(30)) {
+			t.Error("expected FieldOption to return Some(30), got:", v)
+		}
+	})
+
+	t.Run("OptionImplementation", func(t *testing.T) {
+		v := FieldOption[bool](cfg, "Debug")
+
+		if !option.Equals(v, option.Some(true)) {
+			t.Error("expected FieldOption to return Some(true), got:", v)
+		}
+	})
+
+	tests := []struct {
+		name  string
+		field string
+		value option.Option[int]
+	}{
+		{"Zero", "Port", FieldOption[int](cfg, "Port")},
+		{"NilOption", "Timeout", FieldOption[int](&config{}, "Timeout")},
+		{"Missing", "Missing", FieldOption[int](cfg, "Missing")},
+		{"Mistyped", "Name", FieldOption[int](cfg, "Name")},
+		{"Unexported", "secret", FieldOption[int](cfg, "secret")},
+		{"NilEmbedded", "Region", FieldOption[int](cfg, "Region")},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if !option.IsNone(test.value) {
+				t.Errorf("expected FieldOption(%q) to return None, got: %v", test.field, test.value)
+			}
+		})
+	}
+
+	t.Run("Embedded", func(t *testing.T) {
+		v := FieldOption[string](&config{Embedded: &Embedded{Region: "eu"}}, "Region")
+
+		if !option.Equals(v, option.Some("eu")) {
+			t.Error("expected FieldOption to return Some(\"eu\"), got:", v)
+		}
+	})
+
+	t.Run("NotAStructPointer", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected FieldOption to panic")
+			}
+		}()
+
+		FieldOption[string](*cfg, "Name")
+	})
+}
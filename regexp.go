@@ -0,0 +1,24 @@
+package option
+
+import "regexp"
+
+// MatchString returns a Some holding the leftmost match of re in s or returns a None if there is no match.
+func MatchString(re *regexp.Regexp, s string) Option[string] {
+	loc := re.FindStringIndex(s)
+	if loc == nil {
+		return None[string]()
+	}
+
+	return Some(s[loc[0]:loc[1]])
+}
+
+// MatchGroups returns a Some holding the leftmost match of re in s followed by the matches of its subexpressions
+// (see [regexp.Regexp.FindStringSubmatch]) or returns a None if there is no match.
+func MatchGroups(re *regexp.Regexp, s string) Option[[]string] {
+	groups := re.FindStringSubmatch(s)
+	if groups == nil {
+		return None[[]string]()
+	}
+
+	return Some(groups)
+}
@@ -0,0 +1,55 @@
+package option
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestMatchString(t *testing.T) {
+	re := regexp.MustCompile(`\d+`)
+
+	t.Run("Match", func(t *testing.T) {
+		v := MatchString(re, "port 8080 and 9090")
+
+		if !Equals(v, Some("8080")) {
+			t.Error("expected MatchString to return Some(\"8080\"), got:", v)
+		}
+	})
+
+	t.Run("EmptyMatch", func(t *testing.T) {
+		v := MatchString(regexp.MustCompile(`\d*`), "hello")
+
+		if !Equals(v, Some("")) {
+			t.Error("expected MatchString to return Some(\"\"), got:", v)
+		}
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		v := MatchString(re, "hello")
+
+		if !IsNone(v) {
+			t.Error("expected MatchString to return None, got:", v)
+		}
+	})
+}
+
+func TestMatchGroups(t *testing.T) {
+	re := regexp.MustCompile(`(\w+)=(\w+)`)
+
+	t.Run("Match", func(t *testing.T) {
+		v := MatchGroups(re, "key=value")
+
+		if !IsSome(v) || !reflect.DeepEqual(v.Value(), []string{"key=value", "key", "value"}) {
+			t.Error("expected MatchGroups to return the match and its submatches, got:", v)
+		}
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		v := MatchGroups(re, "hello")
+
+		if !IsNone(v) {
+			t.Error("expected MatchGroups to return None, got:", v)
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package option
+
+// Resolver resolves a value from a chain of lazily evaluated sources (eg. flags > env > file > default).
+//
+// Sources are evaluated in order until one of them returns a Some; later sources are not consulted.
+//
+// The zero value is ready to use.
+type Resolver[T any] struct {
+	result Option[T]
+}
+
+// Resolve returns a new Resolver.
+func Resolve[T any]() Resolver[T] {
+	return Resolver[T]{}
+}
+
+// Try evaluates the provided source unless a value has already been resolved.
+func (r Resolver[T]) Try(source func() Option[T]) Resolver[T] {
+	if r.result != nil && IsSome(r.result) {
+		return r
+	}
+
+	return Resolver[T]{
+		result: source(),
+	}
+}
+
+// Option returns the resolved value (if any) or a None.
+func (r Resolver[T]) Option() Option[T] {
+	if r.result == nil {
+		return None[T]()
+	}
+
+	return r.result
+}
+
+// Default returns the resolved value (if any) or returns the provided default value.
+func (r Resolver[T]) Default(d T) T {
+	return UnwrapOr(r.Option(), d)
+}
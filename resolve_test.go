@@ -0,0 +1,74 @@
+package option
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	t.Run("FirstHit", func(t *testing.T) {
+		var calls []string
+
+		source := func(name string, o Option[string]) func() Option[string] {
+			return func() Option[string] {
+				calls = append(calls, name)
+
+				return o
+			}
+		}
+
+		v := Resolve[string]().
+			Try(source("flag", None[string]())).
+			Try(source("env", Some("env"))).
+			Try(source("file", Some("file"))).
+			Default("default")
+
+		if v != "env" {
+			t.Error("expected Resolve to return the first resolved value, got:", v)
+		}
+
+		if len(calls) != 2 || calls[0] != "flag" || calls[1] != "env" {
+			t.Error("expected Resolve not to consult sources after a hit, got calls:", calls)
+		}
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		v := Resolve[string]().
+			Try(func() Option[string] { return None[string]() }).
+			Default("default")
+
+		if v != "default" {
+			t.Error("expected Resolve to return the default value, got:", v)
+		}
+	})
+
+	t.Run("ZeroValue", func(t *testing.T) {
+		var r Resolver[string]
+
+		if v := r.Default("default"); v != "default" {
+			t.Error("expected Resolver to return the default value, got:", v)
+		}
+	})
+
+	t.Run("Option", func(t *testing.T) {
+		v := Resolve[string]().
+			Try(func() Option[string] { return None[string]() }).
+			Option()
+
+		if !IsNone(v) {
+			t.Error("expected Resolve to return None, got:", v)
+		}
+	})
+}
+
+func ExampleResolve() {
+	port := Resolve[int]().
+		Try(func() Option[int] { return None[int]() }). // eg. flags
+		Try(func() Option[int] { return Some(8000) }).  // eg. environment
+		Default(8080)
+
+	fmt.Println(port)
+
+	// Output:
+	// 8000
+}
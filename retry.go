@@ -0,0 +1,30 @@
+package option
+
+import "time"
+
+// Retry calls the provided function up to attempts times and returns the first Some it returns.
+// It returns a None if every attempt returns a None.
+func Retry[T any](attempts int, f func() Option[T]) Option[T] {
+	return RetryWithDelay(attempts, func(int) time.Duration { return 0 }, f)
+}
+
+// RetryWithDelay calls the provided function up to attempts times and returns the first Some it returns.
+// It returns a None if every attempt returns a None.
+//
+// Before every retry, it waits for the duration returned by the delay function.
+// The delay function receives the number of the retry (starting at 1).
+func RetryWithDelay[T any](attempts int, delay func(retry int) time.Duration, f func() Option[T]) Option[T] {
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if d := delay(attempt); d > 0 {
+				time.Sleep(d)
+			}
+		}
+
+		if o := f(); IsSome(o) {
+			return o
+		}
+	}
+
+	return None[T]()
+}
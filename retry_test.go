@@ -0,0 +1,78 @@
+package option
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		var calls int
+
+		v := Retry(5, func() Option[int] {
+			calls++
+
+			if calls < 3 {
+				return None[int]()
+			}
+
+			return Some(calls)
+		})
+
+		if !Equals(v, Some(3)) {
+			t.Error("expected Retry to return Some(3), got:", v)
+		}
+
+		if calls != 3 {
+			t.Error("expected Retry to stop at the first Some, got calls:", calls)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		var calls int
+
+		v := Retry(3, func() Option[int] {
+			calls++
+
+			return None[int]()
+		})
+
+		if !IsNone(v) {
+			t.Error("expected Retry to return None, got:", v)
+		}
+
+		if calls != 3 {
+			t.Error("expected Retry to respect the attempt cap, got calls:", calls)
+		}
+	})
+}
+
+func TestRetryWithDelay(t *testing.T) {
+	var retries []int
+
+	delay := func(retry int) time.Duration {
+		retries = append(retries, retry)
+
+		return time.Millisecond
+	}
+
+	var calls int
+
+	v := RetryWithDelay(3, delay, func() Option[int] {
+		calls++
+
+		return None[int]()
+	})
+
+	if !IsNone(v) {
+		t.Error("expected RetryWithDelay to return None, got:", v)
+	}
+
+	if calls != 3 {
+		t.Error("expected RetryWithDelay to respect the attempt cap, got calls:", calls)
+	}
+
+	if len(retries) != 2 || retries[0] != 1 || retries[1] != 2 {
+		t.Error("expected RetryWithDelay to call the delay function before every retry, got:", retries)
+	}
+}
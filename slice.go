@@ -0,0 +1,221 @@
+package option
+
+// Find returns the first element of s for which the provided predicate returns true or returns a None.
+func Find[T any](s []T, pred func(T) bool) Option[T] {
+	for _, v := range s {
+		if pred(v) {
+			return Some(v)
+		}
+	}
+
+	return None[T]()
+}
+
+// FindIndex returns the index of the first element of s for which the provided predicate returns true or returns a None.
+func FindIndex[T any](s []T, pred func(T) bool) Option[int] {
+	for i, v := range s {
+		if pred(v) {
+			return Some(i)
+		}
+	}
+
+	return None[int]()
+}
+
+// FindBy returns the first element of s whose key (computed by the provided function) equals want or returns a None.
+func FindBy[K comparable, T any](s []T, key func(T) K, want K) Option[T] {
+	return Find(s, func(v T) bool { return key(v) == want })
+}
+
+// Head returns the first element of s or returns a None if s is empty.
+func Head[T any](s []T) Option[T] {
+	if len(s) == 0 {
+		return None[T]()
+	}
+
+	return Some(s[0])
+}
+
+// Last returns the last element of s or returns a None if s is empty.
+func Last[T any](s []T) Option[T] {
+	if len(s) == 0 {
+		return None[T]()
+	}
+
+	return Some(s[len(s)-1])
+}
+
+// SubSlice returns a Some holding s[lo:hi] if the bounds are valid (0 <= lo <= hi <= len(s)) or returns a None.
+//
+// Unlike slicing, it never panics. The returned sub-slice shares the underlying array with s.
+func SubSlice[T any](s []T, lo int, hi int) Option[[]T] {
+	if lo < 0 || lo > hi || hi > len(s) {
+		return None[[]T]()
+	}
+
+	return Some(s[lo:hi])
+}
+
+// SplitOnNone groups consecutive values contained in os into sub-slices, treating Nones as separators.
+//
+// Empty groups (caused by leading, trailing or consecutive Nones) are omitted.
+func SplitOnNone[T any](os []Option[T]) [][]T {
+	var (
+		groups [][]T
+		group  []T
+	)
+
+	for _, o := range os {
+		if IsNone(o) {
+			if len(group) > 0 {
+				groups = append(groups, group)
+				group = nil
+			}
+
+			continue
+		}
+
+		group = append(group, o.Value())
+	}
+
+	if len(group) > 0 {
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// Dedup returns a new slice in which runs of consecutive equal Options (according to Equals) are collapsed into a single Option.
+func Dedup[T comparable](os []Option[T]) []Option[T] {
+	var deduped []Option[T]
+
+	for i, o := range os {
+		if i > 0 && Equals(os[i-1], o) {
+			continue
+		}
+
+		deduped = append(deduped, o)
+	}
+
+	return deduped
+}
+
+// Compact returns a new slice containing only the Options of os that contain a value (preserving their order).
+func Compact[T any](os []Option[T]) []Option[T] {
+	var compacted []Option[T]
+
+	for _, o := range os {
+		if IsSome(o) {
+			compacted = append(compacted, o)
+		}
+	}
+
+	return compacted
+}
+
+// MapWhile applies the provided function to the elements of s and collects the contained values of the results
+// until the function returns a None (the rest of the elements are ignored).
+func MapWhile[T any, U any](s []T, f func(T) Option[U]) []U {
+	var mapped []U
+
+	for _, v := range s {
+		o := f(v)
+		if IsNone(o) {
+			break
+		}
+
+		mapped = append(mapped, o.Value())
+	}
+
+	return mapped
+}
+
+// DropNonePrefix returns the rest of os after dropping the leading Nones.
+//
+// The returned slice shares the underlying array with os.
+func DropNonePrefix[T any](os []Option[T]) []Option[T] {
+	for i, o := range os {
+		if IsSome(o) {
+			return os[i:]
+		}
+	}
+
+	return os[len(os):]
+}
+
+// SomeAll returns a new slice of Options containing each of the provided values.
+func SomeAll[T any](vs ...T) []Option[T] {
+	os := make([]Option[T], 0, len(vs))
+
+	for _, v := range vs {
+		os = append(os, Some(v))
+	}
+
+	return os
+}
+
+// NoneN returns a new slice of n Nones.
+// If n is zero or negative, NoneN returns an empty slice.
+func NoneN[T any](n int) []Option[T] {
+	if n < 0 {
+		n = 0
+	}
+
+	os := make([]Option[T], 0, n)
+
+	for i := 0; i < n; i++ {
+		os = append(os, None[T]())
+	}
+
+	return os
+}
+
+// TryCollect calls the provided sources in order and returns a Some holding all the contained values
+// if every source returns a Some, otherwise returns a None.
+//
+// It stops calling sources at the first error (which propagates back with a None) or at the first None
+// (so errors of the remaining sources are not observed).
+func TryCollect[T any](sources []func() (Option[T], error)) (Option[[]T], error) {
+	values := make([]T, 0, len(sources))
+
+	for _, source := range sources {
+		o, err := source()
+		if err != nil {
+			return None[[]T](), err
+		}
+
+		if IsNone(o) {
+			return None[[]T](), nil
+		}
+
+		values = append(values, o.Value())
+	}
+
+	return Some(values), nil
+}
+
+// Run is a run of consecutive equal Options.
+type Run[T any] struct {
+	Value Option[T]
+	Count int
+}
+
+// RunLength collapses runs of consecutive equal Options (according to Equals) of os into runs counting their length.
+func RunLength[T comparable](os []Option[T]) []Run[T] {
+	var runs []Run[T]
+
+	for _, o := range os {
+		if len(runs) > 0 && Equals(runs[len(runs)-1].Value, o) {
+			runs[len(runs)-1].Count++
+
+			continue
+		}
+
+		runs = append(runs, Run[T]{
+			Value: o,
+			Count: 1,
+		})
+	}
+
+	return runs
+}
@@ -0,0 +1,481 @@
+package option
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	s := []string{"hello", "world", "foo", "bar"}
+
+	t.Run("Found", func(t *testing.T) {
+		tests := []string{"hello", "foo", "bar"}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test, func(t *testing.T) {
+				v := Find(s, func(v string) bool { return v == test })
+
+				if !Equals(v, Some(test)) {
+					t.Errorf("expected Find to return Some(%q), got: %v", test, v)
+				}
+			})
+		}
+	})
+
+	t.Run("FirstMatch", func(t *testing.T) {
+		v := Find(s, func(v string) bool { return len(v) == 3 })
+
+		if !Equals(v, Some("foo")) {
+			t.Error("expected Find to return Some(\"foo\"), got:", v)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		v := Find(s, func(v string) bool { return v == "baz" })
+
+		if !IsNone(v) {
+			t.Error("expected Find to return None, got:", v)
+		}
+	})
+}
+
+func ExampleFind() {
+	s := []string{"hello", "world"}
+
+	fmt.Println(UnwrapOr(Find(s, func(v string) bool { return v[0] == 'w' }), "none"))
+	fmt.Println(UnwrapOr(Find(s, func(v string) bool { return v[0] == 'x' }), "none"))
+
+	// Output:
+	// world
+	// none
+}
+
+func TestFindIndex(t *testing.T) {
+	s := []string{"hello", "world", "foo", "bar"}
+
+	t.Run("Found", func(t *testing.T) {
+		for i, test := range s {
+			i, test := i, test
+
+			t.Run(test, func(t *testing.T) {
+				v := FindIndex(s, func(v string) bool { return v == test })
+
+				if !Equals(v, Some(i)) {
+					t.Errorf("expected FindIndex to return Some(%d), got: %v", i, v)
+				}
+			})
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		v := FindIndex(s, func(v string) bool { return v == "baz" })
+
+		if !IsNone(v) {
+			t.Error("expected FindIndex to return None, got:", v)
+		}
+	})
+}
+
+func TestFindBy(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	users := []user{{1, "John"}, {2, "Jane"}, {2, "Jack"}}
+
+	id := func(u user) int { return u.ID }
+
+	t.Run("Match", func(t *testing.T) {
+		v := FindBy(users, id, 2)
+
+		if !Equals(v, Some(user{2, "Jane"})) {
+			t.Error("expected FindBy to return the first matching element, got:", v)
+		}
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		v := FindBy(users, id, 3)
+
+		if !IsNone(v) {
+			t.Error("expected FindBy to return None, got:", v)
+		}
+	})
+}
+
+func TestHead(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		v := Head([]string{})
+
+		if !IsNone(v) {
+			t.Error("expected Head to return None, got:", v)
+		}
+	})
+
+	t.Run("Single", func(t *testing.T) {
+		v := Head([]string{"hello"})
+
+		if !Equals(v, Some("hello")) {
+			t.Error("expected Head to return Some(\"hello\"), got:", v)
+		}
+	})
+
+	t.Run("Multiple", func(t *testing.T) {
+		v := Head([]string{"hello", "world"})
+
+		if !Equals(v, Some("hello")) {
+			t.Error("expected Head to return Some(\"hello\"), got:", v)
+		}
+	})
+}
+
+func TestLast(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		v := Last([]string{})
+
+		if !IsNone(v) {
+			t.Error("expected Last to return None, got:", v)
+		}
+	})
+
+	t.Run("Single", func(t *testing.T) {
+		v := Last([]string{"hello"})
+
+		if !Equals(v, Some("hello")) {
+			t.Error("expected Last to return Some(\"hello\"), got:", v)
+		}
+	})
+
+	t.Run("Multiple", func(t *testing.T) {
+		v := Last([]string{"hello", "world"})
+
+		if !Equals(v, Some("world")) {
+			t.Error("expected Last to return Some(\"world\"), got:", v)
+		}
+	})
+}
+
+func TestSubSlice(t *testing.T) {
+	s := []string{"a", "b", "c", "d"}
+
+	tests := []struct {
+		name     string
+		lo       int
+		hi       int
+		expected Option[[]string]
+	}{
+		{"Valid", 1, 3, Some([]string{"b", "c"})},
+		{"Full", 0, 4, Some([]string{"a", "b", "c", "d"})},
+		{"Empty", 2, 2, Some([]string{})},
+		{"EmptyAtEnd", 4, 4, Some([]string{})},
+		{"NegativeLo", -1, 2, None[[]string]()},
+		{"LoOutOfRange", 5, 5, None[[]string]()},
+		{"HiOutOfRange", 1, 5, None[[]string]()},
+		{"LoGreaterThanHi", 3, 1, None[[]string]()},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := SubSlice(s, test.lo, test.hi)
+
+			if IsSome(v) != IsSome(test.expected) || !reflect.DeepEqual(v.Value(), test.expected.Value()) {
+				t.Errorf("expected SubSlice(%d, %d) to return %v, got: %v", test.lo, test.hi, test.expected, v)
+			}
+		})
+	}
+}
+
+func TestSplitOnNone(t *testing.T) {
+	n := None[int]()
+
+	tests := []struct {
+		name     string
+		os       []Option[int]
+		expected [][]int
+	}{
+		{"Empty", nil, nil},
+		{"AllSome", []Option[int]{Some(1), Some(2), Some(3)}, [][]int{{1, 2, 3}}},
+		{"AllNone", []Option[int]{n, n}, nil},
+		{"Separated", []Option[int]{Some(1), Some(2), n, Some(3)}, [][]int{{1, 2}, {3}}},
+		{"Leading", []Option[int]{n, Some(1), Some(2)}, [][]int{{1, 2}}},
+		{"Trailing", []Option[int]{Some(1), Some(2), n}, [][]int{{1, 2}}},
+		{"Consecutive", []Option[int]{Some(1), n, n, Some(2)}, [][]int{{1}, {2}}},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := SplitOnNone(test.os)
+
+			if !reflect.DeepEqual(v, test.expected) {
+				t.Errorf("expected SplitOnNone to return %v, got: %v", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestDedup(t *testing.T) {
+	n := None[int]()
+
+	tests := []struct {
+		name     string
+		os       []Option[int]
+		expected []Option[int]
+	}{
+		{"Empty", nil, nil},
+		{"RunOfSomes", []Option[int]{Some(1), Some(1), Some(1), Some(2)}, []Option[int]{Some(1), Some(2)}},
+		{"RunOfNones", []Option[int]{n, n, Some(1), n, n}, []Option[int]{n, Some(1), n}},
+		{"Alternating", []Option[int]{Some(1), n, Some(1), n}, []Option[int]{Some(1), n, Some(1), n}},
+		{"NotConsecutive", []Option[int]{Some(1), Some(2), Some(1)}, []Option[int]{Some(1), Some(2), Some(1)}},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := Dedup(test.os)
+
+			if !equalSlices(v, test.expected) {
+				t.Errorf("expected Dedup to return %v, got: %v", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	t.Run("Mixed", func(t *testing.T) {
+		v := Compact([]Option[int]{None[int](), Some(2), None[int](), Some(1)})
+
+		if !equalSlices(v, []Option[int]{Some(2), Some(1)}) {
+			t.Error("expected Compact to return [Some(2) Some(1)], got:", v)
+		}
+	})
+
+	t.Run("AllNone", func(t *testing.T) {
+		v := Compact([]Option[int]{None[int](), None[int]()})
+
+		if len(v) != 0 {
+			t.Error("expected Compact to return an empty slice, got:", v)
+		}
+	})
+}
+
+func TestMapWhile(t *testing.T) {
+	var calls []string
+
+	parse := func(s string) Option[int] {
+		calls = append(calls, s)
+
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return None[int]()
+		}
+
+		return Some(v)
+	}
+
+	tests := []struct {
+		name     string
+		s        []string
+		expected []int
+		calls    []string
+	}{
+		{"Empty", nil, nil, nil},
+		{"All", []string{"1", "2", "3"}, []int{1, 2, 3}, []string{"1", "2", "3"}},
+		{"Prefix", []string{"1", "2", "x", "3"}, []int{1, 2}, []string{"1", "2", "x"}},
+		{"NoPrefix", []string{"x", "1"}, nil, []string{"x"}},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			calls = nil
+
+			v := MapWhile(test.s, parse)
+
+			if !reflect.DeepEqual(v, test.expected) {
+				t.Errorf("expected MapWhile to return %v, got: %v", test.expected, v)
+			}
+
+			if !reflect.DeepEqual(calls, test.calls) {
+				t.Errorf("expected MapWhile to stop at the first None, got calls: %v", calls)
+			}
+		})
+	}
+}
+
+func TestDropNonePrefix(t *testing.T) {
+	n := None[int]()
+
+	tests := []struct {
+		name     string
+		os       []Option[int]
+		expected []Option[int]
+	}{
+		{"Empty", nil, nil},
+		{"AllNone", []Option[int]{n, n}, nil},
+		{"NoPrefix", []Option[int]{Some(1), n, Some(2)}, []Option[int]{Some(1), n, Some(2)}},
+		{"Mixed", []Option[int]{n, n, Some(1), n, Some(2), n}, []Option[int]{Some(1), n, Some(2), n}},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := DropNonePrefix(test.os)
+
+			if !equalSlices(v, test.expected) {
+				t.Errorf("expected DropNonePrefix to return %v, got: %v", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestSomeAll(t *testing.T) {
+	t.Run("Values", func(t *testing.T) {
+		v := SomeAll(1, 2, 3)
+
+		if !equalSlices(v, []Option[int]{Some(1), Some(2), Some(3)}) {
+			t.Error("expected SomeAll to return [Some(1) Some(2) Some(3)], got:", v)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		v := SomeAll[int]()
+
+		if len(v) != 0 {
+			t.Error("expected SomeAll to return an empty slice, got:", v)
+		}
+	})
+}
+
+func TestNoneN(t *testing.T) {
+	t.Run("Values", func(t *testing.T) {
+		v := NoneN[int](3)
+
+		if !equalSlices(v, []Option[int]{None[int](), None[int](), None[int]()}) {
+			t.Error("expected NoneN to return 3 Nones, got:", v)
+		}
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		v := NoneN[int](0)
+
+		if len(v) != 0 {
+			t.Error("expected NoneN to return an empty slice, got:", v)
+		}
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		v := NoneN[int](-1)
+
+		if v == nil || len(v) != 0 {
+			t.Error("expected NoneN to return an empty slice, got:", v)
+		}
+	})
+}
+
+func TestTryCollect(t *testing.T) {
+	errSource := errors.New("source failed")
+
+	var calls int
+
+	some := func(v int) func() (Option[int], error) {
+		return func() (Option[int], error) {
+			calls++
+
+			return Some(v), nil
+		}
+	}
+
+	none := func() (Option[int], error) {
+		calls++
+
+		return None[int](), nil
+	}
+
+	fail := func() (Option[int], error) {
+		calls++
+
+		return None[int](), errSource
+	}
+
+	tests := []struct {
+		name     string
+		sources  []func() (Option[int], error)
+		expected Option[[]int]
+		err      error
+		calls    int
+	}{
+		{"Empty", nil, Some([]int{}), nil, 0},
+		{"AllSome", []func() (Option[int], error){some(1), some(2), some(3)}, Some([]int{1, 2, 3}), nil, 3},
+		{"None", []func() (Option[int], error){some(1), none, some(3)}, None[[]int](), nil, 2},
+		{"Error", []func() (Option[int], error){some(1), fail, some(3)}, None[[]int](), errSource, 2},
+		{"NoneBeforeError", []func() (Option[int], error){none, fail}, None[[]int](), nil, 1},
+		{"ErrorBeforeNone", []func() (Option[int], error){fail, none}, None[[]int](), errSource, 1},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			calls = 0
+
+			v, err := TryCollect(test.sources)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected TryCollect to return error %v, got: %v", test.err, err)
+			}
+
+			if IsSome(v) != IsSome(test.expected) || !reflect.DeepEqual(v.Value(), test.expected.Value()) {
+				t.Errorf("expected TryCollect to return %v, got: %v", test.expected, v)
+			}
+
+			if calls != test.calls {
+				t.Errorf("expected TryCollect to call %d sources, got: %d", test.calls, calls)
+			}
+		})
+	}
+}
+
+func TestRunLength(t *testing.T) {
+	n := None[int]()
+
+	tests := []struct {
+		name     string
+		os       []Option[int]
+		expected []Run[int]
+	}{
+		{"Empty", nil, nil},
+		{"RunOfSomes", []Option[int]{Some(1), Some(1), Some(1), Some(2)}, []Run[int]{{Some(1), 3}, {Some(2), 1}}},
+		{"RunOfNones", []Option[int]{n, n, Some(1), n, n, n}, []Run[int]{{n, 2}, {Some(1), 1}, {n, 3}}},
+		{"Alternating", []Option[int]{Some(1), n, Some(1), n}, []Run[int]{{Some(1), 1}, {n, 1}, {Some(1), 1}, {n, 1}}},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			v := RunLength(test.os)
+
+			if len(v) != len(test.expected) {
+				t.Fatalf("expected RunLength to return %v, got: %v", test.expected, v)
+			}
+
+			for i := range v {
+				if !Equals(v[i].Value, test.expected[i].Value) || v[i].Count != test.expected[i].Count {
+					t.Errorf("expected RunLength to return %v, got: %v", test.expected, v)
+				}
+			}
+		})
+	}
+}
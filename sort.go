@@ -0,0 +1,52 @@
+//go:build go1.21
+
+package option
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Compare compares two Options according to the following:
+// - Two Nones are equal
+// - A None is less than a Some
+// - Two Somes are compared by their values using [cmp.Compare]
+//
+// The result is -1 if o1 is less than o2, 0 if they are equal and +1 if o1 is greater than o2.
+func Compare[T cmp.Ordered](o1 Option[T], o2 Option[T]) int {
+	switch {
+	case IsNone(o1) && IsNone(o2):
+		return 0
+
+	case IsNone(o1):
+		return -1
+
+	case IsNone(o2):
+		return 1
+	}
+
+	return cmp.Compare(o1.Value(), o2.Value())
+}
+
+// SortNonesFirst sorts a slice of Options in place in ascending order with Nones placed at the beginning of the slice.
+//
+// The sort is stable: values that compare equal (eg. -0.0 and 0.0) keep their original order.
+func SortNonesFirst[T cmp.Ordered](os []Option[T]) {
+	slices.SortStableFunc(os, Compare[T])
+}
+
+// SortNonesLast sorts a slice of Options in place in ascending order with Nones placed at the end of the slice.
+//
+// The sort is stable: values that compare equal (eg. -0.0 and 0.0) keep their original order.
+func SortNonesLast[T cmp.Ordered](os []Option[T]) {
+	slices.SortStableFunc(os, compareNonesLast[T])
+}
+
+// compareNonesLast is like Compare, but a None is greater than a Some.
+func compareNonesLast[T cmp.Ordered](o1 Option[T], o2 Option[T]) int {
+	if IsNone(o1) != IsNone(o2) {
+		return -Compare(o1, o2)
+	}
+
+	return Compare(o1, o2)
+}
@@ -0,0 +1,119 @@
+//go:build go1.21
+
+package option
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		o1       Option[int]
+		o2       Option[int]
+		expected int
+	}{
+		{None[int](), None[int](), 0},
+		{None[int](), Some(1), -1},
+		{Some(1), None[int](), 1},
+		{Some(1), Some(2), -1},
+		{Some(2), Some(1), 1},
+		{Some(1), Some(1), 0},
+	}
+
+	for _, test := range tests {
+		if v := Compare(test.o1, test.o2); v != test.expected {
+			t.Errorf("expected Compare(%v, %v) to return %d, got: %d", test.o1, test.o2, test.expected, v)
+		}
+	}
+}
+
+func TestSortNonesFirst(t *testing.T) {
+	os := []Option[int]{Some(3), None[int](), Some(1), Some(2), None[int](), Some(1)}
+
+	SortNonesFirst(os)
+
+	expected := []Option[int]{None[int](), None[int](), Some(1), Some(1), Some(2), Some(3)}
+
+	if !equalSlices(os, expected) {
+		t.Error("expected SortNonesFirst to sort values with Nones first, got:", os)
+	}
+}
+
+func TestSortNonesLast(t *testing.T) {
+	os := []Option[int]{Some(3), None[int](), Some(1), Some(2), None[int](), Some(1)}
+
+	SortNonesLast(os)
+
+	expected := []Option[int]{Some(1), Some(1), Some(2), Some(3), None[int](), None[int]()}
+
+	if !equalSlices(os, expected) {
+		t.Error("expected SortNonesLast to sort values with Nones last, got:", os)
+	}
+}
+
+func TestSort_Stable(t *testing.T) {
+	// -0.0 and 0.0 compare equal, but they can be told apart by their sign
+	zeroSigns := func(os []Option[float64]) []bool {
+		var signs []bool
+
+		for _, o := range os {
+			if IsSome(o) && o.Value() == 0 {
+				signs = append(signs, math.Signbit(o.Value()))
+			}
+		}
+
+		return signs
+	}
+
+	tests := []struct {
+		name string
+		sort func([]Option[float64])
+	}{
+		{"NonesFirst", SortNonesFirst[float64]},
+		{"NonesLast", SortNonesLast[float64]},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			var os []Option[float64]
+
+			// Large enough not to be sorted by insertion sort alone
+			for i := 0; i < 100; i++ {
+				zero := 0.0
+				if i%3 == 0 {
+					zero = math.Copysign(0, -1)
+				}
+
+				os = append(os, Some(zero), None[float64](), Some(float64(i%7+1)), Some(float64(-i%5-1)))
+			}
+
+			expected := zeroSigns(os)
+
+			test.sort(os)
+
+			if v := zeroSigns(os); !slices.Equal(v, expected) {
+				t.Error("expected equal values to keep their original order, got:", v)
+			}
+		})
+	}
+}
+
+func ExampleSortNonesLast() {
+	os := []Option[string]{Some("world"), None[string](), Some("hello")}
+
+	SortNonesLast(os)
+
+	for _, o := range os {
+		fmt.Println(UnwrapOr(o, "none"))
+	}
+
+	// Output:
+	// hello
+	// world
+	// none
+}
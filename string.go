@@ -0,0 +1,45 @@
+package option
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// String returns the result of calling the String method of the contained value (if any) or returns "none".
+func String[T fmt.Stringer](o Option[T]) string {
+	return StringOr(o, "none")
+}
+
+// StringOr returns the result of calling the String method of the contained value (if any) or returns the provided placeholder.
+func StringOr[T fmt.Stringer](o Option[T], placeholder string) string {
+	if IsNone(o) {
+		return placeholder
+	}
+
+	return o.Value().String()
+}
+
+// SomeTrimmed returns a Some holding s with leading and trailing white space removed
+// or returns a None if nothing remains after trimming.
+func SomeTrimmed(s string) Option[string] {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return None[string]()
+	}
+
+	return Some(s)
+}
+
+// Debug returns a Go-syntax representation of o including the name of its element type (eg. Some[int](42) or None[int]).
+//
+// It is meant to be used for diagnostics (eg. test failure messages) in generic code.
+func Debug[T any](o Option[T]) string {
+	typ := reflect.TypeOf((*T)(nil)).Elem().String()
+
+	if IsNone(o) {
+		return fmt.Sprintf("None[%s]", typ)
+	}
+
+	return fmt.Sprintf("Some[%s](%#v)", typ, o.Value())
+}
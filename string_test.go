@@ -0,0 +1,113 @@
+package option
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestString(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v := String(Some(time.Second))
+
+		if v != "1s" {
+			t.Error("expected String to return \"1s\", got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v := String(None[time.Duration]())
+
+		if v != "none" {
+			t.Error("expected String to return \"none\", got:", v)
+		}
+	})
+}
+
+func ExampleString() {
+	fmt.Println(String(Some(time.Minute)))
+	fmt.Println(String(None[time.Duration]()))
+
+	// Output:
+	// 1m0s
+	// none
+}
+
+func TestStringOr(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v := StringOr(Some(time.Second), "-")
+
+		if v != "1s" {
+			t.Error("expected StringOr to return \"1s\", got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v := StringOr(None[time.Duration](), "-")
+
+		if v != "-" {
+			t.Error("expected StringOr to return \"-\", got:", v)
+		}
+	})
+}
+
+func TestSomeTrimmed(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Option[string]
+	}{
+		{"", None[string]()},
+		{"  ", None[string]()},
+		{"\t\n", None[string]()},
+		{"x", Some("x")},
+		{"  x  ", Some("x")},
+		{" hello world\n", Some("hello world")},
+	}
+
+	for _, test := range tests {
+		v := SomeTrimmed(test.input)
+
+		if !Equals(v, test.expected) {
+			t.Errorf("expected SomeTrimmed(%q) to return %v, got: %v", test.input, test.expected, v)
+		}
+	}
+}
+
+type debugPoint struct {
+	X int
+	Y int
+}
+
+func TestDebug(t *testing.T) {
+	tests := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{"Some", Debug(Some(42)), "Some[int](42)"},
+		{"None", Debug(None[int]()), "None[int]"},
+		{"String", Debug(Some("hello")), `Some[string]("hello")`},
+		{"Struct", Debug(Some(debugPoint{X: 1, Y: 2})), "Some[option.debugPoint](option.debugPoint{X:1, Y:2})"},
+		{"StructNone", Debug(None[debugPoint]()), "None[option.debugPoint]"},
+		{"Interface", Debug(None[error]()), "None[error]"},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			if test.actual != test.expected {
+				t.Errorf("expected Debug to return %q, got: %q", test.expected, test.actual)
+			}
+		})
+	}
+}
+
+func ExampleDebug() {
+	fmt.Println(Debug(Some(42)))
+	fmt.Println(Debug(None[string]()))
+
+	// Output:
+	// Some[int](42)
+	// None[string]
+}
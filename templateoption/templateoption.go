@@ -0,0 +1,93 @@
+// Package templateoption provides template functions for working with optional values.
+//
+// Templates are dynamically typed, so the functions inspect their arguments using reflection:
+// they accept any value implementing [option.Option] (and treat a nil Option as a None).
+package templateoption
+
+import (
+	"fmt"
+	"reflect"
+	"text/template"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+// FuncMap returns the following template functions:
+//   - isSome: reports whether an Option contains a value
+//   - isNone: reports whether an Option does not contain a value
+//   - unwrap: returns the contained value or fails with an error wrapping [option.ErrNoValue]
+//   - unwrapOr: returns the contained value or the default value passed as the second argument
+//   - value: returns the contained value or the default value of the type
+//
+// The functions fail with an error if their first argument is not an Option.
+//
+// The returned map can be used with html/template as well:
+//
+//	template.New("name").Funcs(htmltemplate.FuncMap(templateoption.FuncMap()))
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"isSome": func(o any) (bool, error) {
+			_, ok, err := optionState(o)
+
+			return ok, err
+		},
+		"isNone": func(o any) (bool, error) {
+			_, ok, err := optionState(o)
+
+			return !ok, err
+		},
+		"unwrap": func(o any) (any, error) {
+			v, ok, err := optionState(o)
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				return nil, option.ErrNoValue
+			}
+
+			return v, nil
+		},
+		"unwrapOr": func(o any, d any) (any, error) {
+			v, ok, err := optionState(o)
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				return d, nil
+			}
+
+			return v, nil
+		},
+		"value": func(o any) (any, error) {
+			v, _, err := optionState(o)
+
+			return v, err
+		},
+	}
+}
+
+// optionState returns the value stored in o (the default value of the type for a None) and whether o contains a value.
+func optionState(o any) (any, bool, error) {
+	if o == nil {
+		return nil, false, nil
+	}
+
+	v := reflect.ValueOf(o)
+
+	hasValue := v.MethodByName("HasValue")
+	value := v.MethodByName("Value")
+
+	if !hasValue.IsValid() || !value.IsValid() ||
+		hasValue.Type().NumIn() != 0 || hasValue.Type().NumOut() != 1 || hasValue.Type().Out(0).Kind() != reflect.Bool ||
+		value.Type().NumIn() != 0 || value.Type().NumOut() != 1 {
+		return nil, false, fmt.Errorf("expected an option, got %T", o)
+	}
+
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, false, nil
+	}
+
+	return value.Call(nil)[0].Interface(), hasValue.Call(nil)[0].Bool(), nil
+}
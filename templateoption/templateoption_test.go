@@ -0,0 +1,104 @@
+package templateoption
+
+import (
+	"errors"
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/sagikazarmark/go-option"
+)
+
+type page struct {
+	Title    option.Option[string]
+	Subtitle option.Option[string]
+	Views    option.Field[int]
+	Author   option.Option[string]
+	Name     string
+}
+
+func render(t *testing.T, text string, data any) (string, error) {
+	t.Helper()
+
+	tmpl, err := template.New("test").Funcs(FuncMap()).Parse(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf strings.Builder
+
+	err = tmpl.Execute(&buf, data)
+
+	return buf.String(), err
+}
+
+func TestFuncMap(t *testing.T) {
+	p := page{
+		Title:    option.Some("Hello"),
+		Subtitle: option.None[string](),
+		Views:    option.NewField(option.Some(42)),
+		Name:     "home",
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"IsSome", `{{ isSome .Title }} {{ isSome .Subtitle }} {{ isSome .Author }}`, "true false false"},
+		{"IsNone", `{{ isNone .Title }} {{ isNone .Subtitle }} {{ isNone .Author }}`, "false true true"},
+		{"Unwrap", `{{ unwrap .Title }} {{ unwrap .Views }}`, "Hello 42"},
+		{"UnwrapOr", `{{ unwrapOr .Title "default" }} {{ unwrapOr .Subtitle "default" }} {{ unwrapOr .Author "anonymous" }}`, "Hello default anonymous"},
+		{"Value", `{{ value .Title }}|{{ value .Subtitle }}|{{ value .Views }}`, "Hello||42"},
+		{"If", `{{ if isSome .Subtitle }}{{ unwrap .Subtitle }}{{ else }}no subtitle{{ end }}`, "no subtitle"},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			out, err := render(t, test.text, p)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			if out != test.expected {
+				t.Errorf("expected %q, got: %q", test.expected, out)
+			}
+		})
+	}
+
+	t.Run("UnwrapNone", func(t *testing.T) {
+		_, err := render(t, `{{ unwrap .Subtitle }}`, p)
+
+		if !errors.Is(err, option.ErrNoValue) {
+			t.Error("expected unwrap to fail with ErrNoValue, got:", err)
+		}
+	})
+
+	t.Run("NotAnOption", func(t *testing.T) {
+		_, err := render(t, `{{ isSome .Name }}`, p)
+
+		if err == nil {
+			t.Error("expected isSome to fail for a value that is not an Option")
+		}
+	})
+
+	t.Run("HTML", func(t *testing.T) {
+		tmpl, err := htmltemplate.New("test").Funcs(htmltemplate.FuncMap(FuncMap())).Parse(`<h1>{{ unwrapOr .Title "Untitled" }}</h1>`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var buf strings.Builder
+
+		if err := tmpl.Execute(&buf, page{Title: option.Some("<b>")}); err != nil {
+			t.Fatal(err)
+		}
+
+		if buf.String() != "<h1>&lt;b&gt;</h1>" {
+			t.Error("expected the contained value to be rendered (and escaped), got:", buf.String())
+		}
+	})
+}
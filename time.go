@@ -0,0 +1,49 @@
+package option
+
+import "time"
+
+// ParseTime parses a formatted string (see [time.Parse]) and returns a Some holding the time value it represents
+// or returns a None if the string cannot be parsed.
+func ParseTime(layout string, value string) Option[time.Time] {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return None[time.Time]()
+	}
+
+	return Some(t)
+}
+
+// ParseDuration parses a duration string (see [time.ParseDuration]) and returns a Some holding the duration it represents
+// or returns a None if the string cannot be parsed.
+func ParseDuration(s string) Option[time.Duration] {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return None[time.Duration]()
+	}
+
+	return Some(d)
+}
+
+// TimeText formats the contained time value (if any) according to layout (see [time.Time.Format]).
+// It returns an empty text for a None.
+//
+// It is meant to be used for implementing [encoding.TextMarshaler] for optional times in custom layouts (eg. in configuration files).
+func TimeText(o Option[time.Time], layout string) ([]byte, error) {
+	if IsNone(o) {
+		return []byte{}, nil
+	}
+
+	return []byte(o.Value().Format(layout)), nil
+}
+
+// DurationText formats the contained duration (if any) as a string (see [time.Duration.String]).
+// It returns an empty text for a None.
+//
+// It is meant to be used for implementing [encoding.TextMarshaler] for optional durations (eg. in configuration files).
+func DurationText(o Option[time.Duration]) ([]byte, error) {
+	if IsNone(o) {
+		return []byte{}, nil
+	}
+
+	return []byte(o.Value().String()), nil
+}
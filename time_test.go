@@ -0,0 +1,90 @@
+package option
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		v := ParseTime("2006-01-02", "2022-01-01")
+
+		if !EqualsEq(v, Some(time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC))) {
+			t.Error("expected ParseTime to return Some(2022-01-01), got:", v)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		v := ParseTime("2006-01-02", "hello")
+
+		if !IsNone(v) {
+			t.Error("expected ParseTime to return None, got:", v)
+		}
+	})
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		v := ParseDuration("1m30s")
+
+		if !Equals(v, Some(90*time.Second)) {
+			t.Error("expected ParseDuration to return Some(1m30s), got:", v)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		v := ParseDuration("hello")
+
+		if !IsNone(v) {
+			t.Error("expected ParseDuration to return None, got:", v)
+		}
+	})
+}
+
+func TestTimeText(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		text, err := TimeText(Some(time.Date(2022, time.January, 2, 15, 4, 0, 0, time.UTC)), "02/01/2006 15:04")
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if string(text) != "02/01/2022 15:04" {
+			t.Error("expected TimeText to return \"02/01/2022 15:04\", got:", string(text))
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		text, err := TimeText(None[time.Time](), time.RFC3339)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if text == nil || len(text) != 0 {
+			t.Error("expected TimeText to return an empty text, got:", text)
+		}
+	})
+}
+
+func TestDurationText(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		text, err := DurationText(Some(90 * time.Second))
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if string(text) != "1m30s" {
+			t.Error("expected DurationText to return \"1m30s\", got:", string(text))
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		text, err := DurationText(None[time.Duration]())
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if text == nil || len(text) != 0 {
+			t.Error("expected DurationText to return an empty text, got:", text)
+		}
+	})
+}
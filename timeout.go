@@ -0,0 +1,28 @@
+package option
+
+import "time"
+
+// WithTimeout runs the provided function in a new goroutine and returns a Some holding its result
+// if it completes within d, otherwise returns a None.
+//
+// Note: the function is not interrupted when the timeout expires.
+// It keeps running in the background and its result is discarded once it completes.
+func WithTimeout[T any](d time.Duration, f func() T) Option[T] {
+	// Buffered, so the goroutine can exit even after a timeout
+	result := make(chan T, 1)
+
+	go func() {
+		result <- f()
+	}()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case v := <-result:
+		return Some(v)
+
+	case <-timer.C:
+		return None[T]()
+	}
+}
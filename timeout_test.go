@@ -0,0 +1,31 @@
+package option
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("Fast", func(t *testing.T) {
+		v := WithTimeout(time.Second, func() string { return "hello" })
+
+		if !Equals(v, Some("hello")) {
+			t.Error("expected WithTimeout to return Some(\"hello\"), got:", v)
+		}
+	})
+
+	t.Run("Slow", func(t *testing.T) {
+		done := make(chan struct{})
+		defer close(done)
+
+		v := WithTimeout(10*time.Millisecond, func() string {
+			<-done
+
+			return "hello"
+		})
+
+		if !IsNone(v) {
+			t.Error("expected WithTimeout to return None, got:", v)
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package option
+
+// Pair holds two values of (possibly) different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip returns a Pair of the contained values if both Options contain a value, otherwise returns a None.
+func Zip[A any, B any](a Option[A], b Option[B]) Option[Pair[A, B]] {
+	return ZipWith(a, b, func(a A, b B) Pair[A, B] {
+		return Pair[A, B]{
+			First:  a,
+			Second: b,
+		}
+	})
+}
+
+// ZipWith applies the provided function to the contained values if both Options contain a value, otherwise returns a None.
+func ZipWith[A any, B any, R any](a Option[A], b Option[B], f func(a A, b B) R) Option[R] {
+	if IsNone(a) || IsNone(b) {
+		return None[R]()
+	}
+
+	return Some(f(a.Value(), b.Value()))
+}
+
+// ProductSlice returns the Cartesian product of two slices of Options:
+// every element of as is zipped (see Zip) with every element of bs.
+//
+// The result has len(as)*len(bs) elements ordered by as first: (as[0], bs[0]), (as[0], bs[1]), ...
+func ProductSlice[A any, B any](as []Option[A], bs []Option[B]) []Option[Pair[A, B]] {
+	product := make([]Option[Pair[A, B]], 0, len(as)*len(bs))
+
+	for _, a := range as {
+		for _, b := range bs {
+			product = append(product, Zip(a, b))
+		}
+	}
+
+	return product
+}
+
+// ZipSlices pairs the elements of as and bs by index if the slices have the same length, otherwise returns a None.
+func ZipSlices[A any, B any](as []A, bs []B) Option[[]Pair[A, B]] {
+	if len(as) != len(bs) {
+		return None[[]Pair[A, B]]()
+	}
+
+	zipped := make([]Pair[A, B], 0, len(as))
+
+	for i := range as {
+		zipped = append(zipped, Pair[A, B]{
+			First:  as[i],
+			Second: bs[i],
+		})
+	}
+
+	return Some(zipped)
+}
+
+// Triple holds three values of (possibly) different types.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip3 returns a Triple of the contained values if all three Options contain a value, otherwise returns a None.
+func Zip3[A any, B any, C any](a Option[A], b Option[B], c Option[C]) Option[Triple[A, B, C]] {
+	return ZipWith3(a, b, c, func(a A, b B, c C) Triple[A, B, C] {
+		return Triple[A, B, C]{
+			First:  a,
+			Second: b,
+			Third:  c,
+		}
+	})
+}
+
+// ZipWith3 applies the provided function to the contained values if all three Options contain a value, otherwise returns a None.
+func ZipWith3[A any, B any, C any, R any](a Option[A], b Option[B], c Option[C], f func(a A, b B, c C) R) Option[R] {
+	if IsNone(a) || IsNone(b) || IsNone(c) {
+		return None[R]()
+	}
+
+	return Some(f(a.Value(), b.Value(), c.Value()))
+}
@@ -0,0 +1,192 @@
+package option
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v := Zip(Some("hello"), Some(1))
+
+		if !Equals(v, Some(Pair[string, int]{"hello", 1})) {
+			t.Error("expected Zip to return Some(Pair{\"hello\", 1}), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		tests := []struct {
+			name string
+			a    Option[string]
+			b    Option[int]
+		}{
+			{"First", None[string](), Some(1)},
+			{"Second", Some("hello"), None[int]()},
+			{"Both", None[string](), None[int]()},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				v := Zip(test.a, test.b)
+
+				if !IsNone(v) {
+					t.Error("expected Zip to return None, got:", v)
+				}
+			})
+		}
+	})
+}
+
+func TestZipWith(t *testing.T) {
+	f := func(a string, b int) string { return a + strconv.Itoa(b) }
+
+	t.Run("Some", func(t *testing.T) {
+		v := ZipWith(Some("hello"), Some(1), f)
+
+		if !Equals(v, Some("hello1")) {
+			t.Error("expected ZipWith to return Some(\"hello1\"), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		v := ZipWith(Some("hello"), None[int](), f)
+
+		if !IsNone(v) {
+			t.Error("expected ZipWith to return None, got:", v)
+		}
+	})
+}
+
+func TestProductSlice(t *testing.T) {
+	as := []Option[string]{Some("a"), None[string](), Some("b")}
+	bs := []Option[int]{Some(1), Some(2)}
+
+	v := ProductSlice(as, bs)
+
+	expected := []Option[Pair[string, int]]{
+		Some(Pair[string, int]{"a", 1}),
+		Some(Pair[string, int]{"a", 2}),
+		None[Pair[string, int]](),
+		None[Pair[string, int]](),
+		Some(Pair[string, int]{"b", 1}),
+		Some(Pair[string, int]{"b", 2}),
+	}
+
+	if !equalSlices(v, expected) {
+		t.Error("expected ProductSlice to return the Cartesian product, got:", v)
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		v := ProductSlice[string, int](as, nil)
+
+		if len(v) != 0 {
+			t.Error("expected ProductSlice to return an empty slice, got:", v)
+		}
+	})
+}
+
+func TestZipSlices(t *testing.T) {
+	t.Run("EqualLength", func(t *testing.T) {
+		v := ZipSlices([]string{"a", "b"}, []int{1, 2})
+
+		expected := []Pair[string, int]{{"a", 1}, {"b", 2}}
+
+		if !IsSome(v) || !reflect.DeepEqual(v.Value(), expected) {
+			t.Errorf("expected ZipSlices to return Some(%v), got: %v", expected, v)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		v := ZipSlices[string, int](nil, nil)
+
+		if !IsSome(v) || len(v.Value()) != 0 {
+			t.Error("expected ZipSlices to return Some of an empty slice, got:", v)
+		}
+	})
+
+	t.Run("MismatchedLength", func(t *testing.T) {
+		v := ZipSlices([]string{"a", "b"}, []int{1})
+
+		if !IsNone(v) {
+			t.Error("expected ZipSlices to return None, got:", v)
+		}
+	})
+}
+
+func TestZip3(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		v := Zip3(Some("hello"), Some(1), Some(true))
+
+		if !Equals(v, Some(Triple[string, int, bool]{"hello", 1, true})) {
+			t.Error("expected Zip3 to return Some(Triple{\"hello\", 1, true}), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		tests := []struct {
+			name string
+			a    Option[string]
+			b    Option[int]
+			c    Option[bool]
+		}{
+			{"First", None[string](), Some(1), Some(true)},
+			{"Second", Some("hello"), None[int](), Some(true)},
+			{"Third", Some("hello"), Some(1), None[bool]()},
+			{"All", None[string](), None[int](), None[bool]()},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				v := Zip3(test.a, test.b, test.c)
+
+				if !IsNone(v) {
+					t.Error("expected Zip3 to return None, got:", v)
+				}
+			})
+		}
+	})
+}
+
+func TestZipWith3(t *testing.T) {
+	f := func(a string, b int, c bool) string {
+		return a + strconv.Itoa(b) + strconv.FormatBool(c)
+	}
+
+	t.Run("Some", func(t *testing.T) {
+		v := ZipWith3(Some("hello"), Some(1), Some(true), f)
+
+		if !Equals(v, Some("hello1true")) {
+			t.Error("expected ZipWith3 to return Some(\"hello1true\"), got:", v)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		tests := []struct {
+			name string
+			a    Option[string]
+			b    Option[int]
+			c    Option[bool]
+		}{
+			{"First", None[string](), Some(1), Some(true)},
+			{"Second", Some("hello"), None[int](), Some(true)},
+			{"Third", Some("hello"), Some(1), None[bool]()},
+		}
+
+		for _, test := range tests {
+			test := test
+
+			t.Run(test.name, func(t *testing.T) {
+				v := ZipWith3(test.a, test.b, test.c, f)
+
+				if !IsNone(v) {
+					t.Error("expected ZipWith3 to return None, got:", v)
+				}
+			})
+		}
+	})
+}